@@ -20,6 +20,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RefreshAction int32
+
+const (
+	RefreshAction_REFRESH_ACTION_UNCHANGED RefreshAction = 0
+	RefreshAction_REFRESH_ACTION_ADDED     RefreshAction = 1
+	RefreshAction_REFRESH_ACTION_UPDATED   RefreshAction = 2
+	RefreshAction_REFRESH_ACTION_REMOVED   RefreshAction = 3
+)
+
+// Enum value maps for RefreshAction.
+var (
+	RefreshAction_name = map[int32]string{
+		0: "REFRESH_ACTION_UNCHANGED",
+		1: "REFRESH_ACTION_ADDED",
+		2: "REFRESH_ACTION_UPDATED",
+		3: "REFRESH_ACTION_REMOVED",
+	}
+	RefreshAction_value = map[string]int32{
+		"REFRESH_ACTION_UNCHANGED": 0,
+		"REFRESH_ACTION_ADDED":     1,
+		"REFRESH_ACTION_UPDATED":   2,
+		"REFRESH_ACTION_REMOVED":   3,
+	}
+)
+
+func (x RefreshAction) Enum() *RefreshAction {
+	p := new(RefreshAction)
+	*p = x
+	return p
+}
+
+func (x RefreshAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefreshAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_connection_proto_enumTypes[0].Descriptor()
+}
+
+func (RefreshAction) Type() protoreflect.EnumType {
+	return &file_proto_connection_proto_enumTypes[0]
+}
+
+func (x RefreshAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefreshAction.Descriptor instead.
+func (RefreshAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{0}
+}
+
 type TopicMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -136,6 +188,10 @@ type Connection struct {
 	ClientName   string       `protobuf:"bytes,7,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
 	Owner        *User        `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	DnsDetails   *DNSDetails  `protobuf:"bytes,9,opt,name=dns_details,json=dnsDetails,proto3" json:"dns_details,omitempty"`
+	Retention    *Retention   `protobuf:"bytes,10,opt,name=retention,proto3" json:"retention,omitempty"`
+	// sinks receiving messages of every subscription of the connection
+	Sinks    []*Sink   `protobuf:"bytes,11,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	LogLevel *LogLevel `protobuf:"bytes,12,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
 }
 
 func (x *Connection) Reset() {
@@ -233,6 +289,84 @@ func (x *Connection) GetDnsDetails() *DNSDetails {
 	return nil
 }
 
+func (x *Connection) GetRetention() *Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Connection) GetSinks() []*Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+func (x *Connection) GetLogLevel() *LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return nil
+}
+
+// LogLevel overrides the server log level for the logs of a connection printed
+// to stdout and stored in the database, empty if not overridden
+type LogLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stdout string `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Db     string `protobuf:"bytes,2,opt,name=db,proto3" json:"db,omitempty"`
+}
+
+func (x *LogLevel) Reset() {
+	*x = LogLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevel) ProtoMessage() {}
+
+func (x *LogLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevel.ProtoReflect.Descriptor instead.
+func (*LogLevel) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogLevel) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *LogLevel) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
 type GetConnectionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -244,7 +378,7 @@ type GetConnectionsRequest struct {
 func (x *GetConnectionsRequest) Reset() {
 	*x = GetConnectionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[3]
+		mi := &file_proto_connection_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -257,7 +391,7 @@ func (x *GetConnectionsRequest) String() string {
 func (*GetConnectionsRequest) ProtoMessage() {}
 
 func (x *GetConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[3]
+	mi := &file_proto_connection_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,7 +404,7 @@ func (x *GetConnectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionsRequest.ProtoReflect.Descriptor instead.
 func (*GetConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{3}
+	return file_proto_connection_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetConnectionsRequest) GetUser() *User {
@@ -291,7 +425,7 @@ type GetConnectionsResponse struct {
 func (x *GetConnectionsResponse) Reset() {
 	*x = GetConnectionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[4]
+		mi := &file_proto_connection_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -304,7 +438,7 @@ func (x *GetConnectionsResponse) String() string {
 func (*GetConnectionsResponse) ProtoMessage() {}
 
 func (x *GetConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[4]
+	mi := &file_proto_connection_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +451,7 @@ func (x *GetConnectionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionsResponse.ProtoReflect.Descriptor instead.
 func (*GetConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{4}
+	return file_proto_connection_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetConnectionsResponse) GetConnections() []*Connection {
@@ -338,7 +472,7 @@ type GetConnectionsTotalRequest struct {
 func (x *GetConnectionsTotalRequest) Reset() {
 	*x = GetConnectionsTotalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[5]
+		mi := &file_proto_connection_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +485,7 @@ func (x *GetConnectionsTotalRequest) String() string {
 func (*GetConnectionsTotalRequest) ProtoMessage() {}
 
 func (x *GetConnectionsTotalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[5]
+	mi := &file_proto_connection_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +498,7 @@ func (x *GetConnectionsTotalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionsTotalRequest.ProtoReflect.Descriptor instead.
 func (*GetConnectionsTotalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{5}
+	return file_proto_connection_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetConnectionsTotalRequest) GetUser() *User {
@@ -385,7 +519,7 @@ type GetConnectionsTotalResponse struct {
 func (x *GetConnectionsTotalResponse) Reset() {
 	*x = GetConnectionsTotalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[6]
+		mi := &file_proto_connection_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -398,7 +532,7 @@ func (x *GetConnectionsTotalResponse) String() string {
 func (*GetConnectionsTotalResponse) ProtoMessage() {}
 
 func (x *GetConnectionsTotalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[6]
+	mi := &file_proto_connection_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +545,7 @@ func (x *GetConnectionsTotalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionsTotalResponse.ProtoReflect.Descriptor instead.
 func (*GetConnectionsTotalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{6}
+	return file_proto_connection_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetConnectionsTotalResponse) GetTotal() int64 {
@@ -440,7 +574,7 @@ type CreateConnectionRequest struct {
 func (x *CreateConnectionRequest) Reset() {
 	*x = CreateConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[7]
+		mi := &file_proto_connection_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +587,7 @@ func (x *CreateConnectionRequest) String() string {
 func (*CreateConnectionRequest) ProtoMessage() {}
 
 func (x *CreateConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[7]
+	mi := &file_proto_connection_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +600,7 @@ func (x *CreateConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateConnectionRequest.ProtoReflect.Descriptor instead.
 func (*CreateConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{7}
+	return file_proto_connection_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateConnectionRequest) GetUser() *User {
@@ -543,7 +677,7 @@ type CreateConnectionResponse struct {
 func (x *CreateConnectionResponse) Reset() {
 	*x = CreateConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[8]
+		mi := &file_proto_connection_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -556,7 +690,7 @@ func (x *CreateConnectionResponse) String() string {
 func (*CreateConnectionResponse) ProtoMessage() {}
 
 func (x *CreateConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[8]
+	mi := &file_proto_connection_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +703,7 @@ func (x *CreateConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateConnectionResponse.ProtoReflect.Descriptor instead.
 func (*CreateConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{8}
+	return file_proto_connection_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateConnectionResponse) GetConnection() *Connection {
@@ -591,7 +725,7 @@ type GetConnectionRequest struct {
 func (x *GetConnectionRequest) Reset() {
 	*x = GetConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[9]
+		mi := &file_proto_connection_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -604,7 +738,7 @@ func (x *GetConnectionRequest) String() string {
 func (*GetConnectionRequest) ProtoMessage() {}
 
 func (x *GetConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[9]
+	mi := &file_proto_connection_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +751,7 @@ func (x *GetConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionRequest.ProtoReflect.Descriptor instead.
 func (*GetConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{9}
+	return file_proto_connection_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetConnectionRequest) GetUser() *User {
@@ -645,7 +779,7 @@ type GetConnectionResponse struct {
 func (x *GetConnectionResponse) Reset() {
 	*x = GetConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[10]
+		mi := &file_proto_connection_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -658,7 +792,7 @@ func (x *GetConnectionResponse) String() string {
 func (*GetConnectionResponse) ProtoMessage() {}
 
 func (x *GetConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[10]
+	mi := &file_proto_connection_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +805,7 @@ func (x *GetConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionResponse.ProtoReflect.Descriptor instead.
 func (*GetConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{10}
+	return file_proto_connection_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetConnectionResponse) GetConnection() *Connection {
@@ -698,12 +832,13 @@ type UpdateConnectionRequest struct {
 	Owner        *NullableString           `protobuf:"bytes,11,opt,name=owner,proto3" json:"owner,omitempty"`
 	InsecureTls  *NullableBool             `protobuf:"bytes,12,opt,name=insecure_tls,json=insecureTls,proto3" json:"insecure_tls,omitempty"`
 	Ca           *NullableStringList       `protobuf:"bytes,13,opt,name=ca,proto3" json:"ca,omitempty"`
+	Retention    *NullableRetention        `protobuf:"bytes,14,opt,name=retention,proto3" json:"retention,omitempty"`
 }
 
 func (x *UpdateConnectionRequest) Reset() {
 	*x = UpdateConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[11]
+		mi := &file_proto_connection_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -716,7 +851,7 @@ func (x *UpdateConnectionRequest) String() string {
 func (*UpdateConnectionRequest) ProtoMessage() {}
 
 func (x *UpdateConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[11]
+	mi := &file_proto_connection_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,7 +864,7 @@ func (x *UpdateConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConnectionRequest.ProtoReflect.Descriptor instead.
 func (*UpdateConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{11}
+	return file_proto_connection_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateConnectionRequest) GetUser() *User {
@@ -816,6 +951,13 @@ func (x *UpdateConnectionRequest) GetCa() *NullableStringList {
 	return nil
 }
 
+func (x *UpdateConnectionRequest) GetRetention() *NullableRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
 type UpdateConnectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -825,7 +967,7 @@ type UpdateConnectionResponse struct {
 func (x *UpdateConnectionResponse) Reset() {
 	*x = UpdateConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[12]
+		mi := &file_proto_connection_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -838,7 +980,7 @@ func (x *UpdateConnectionResponse) String() string {
 func (*UpdateConnectionResponse) ProtoMessage() {}
 
 func (x *UpdateConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[12]
+	mi := &file_proto_connection_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +993,7 @@ func (x *UpdateConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConnectionResponse.ProtoReflect.Descriptor instead.
 func (*UpdateConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{12}
+	return file_proto_connection_proto_rawDescGZIP(), []int{13}
 }
 
 type DeleteConnectionRequest struct {
@@ -866,7 +1008,7 @@ type DeleteConnectionRequest struct {
 func (x *DeleteConnectionRequest) Reset() {
 	*x = DeleteConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[13]
+		mi := &file_proto_connection_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -879,7 +1021,7 @@ func (x *DeleteConnectionRequest) String() string {
 func (*DeleteConnectionRequest) ProtoMessage() {}
 
 func (x *DeleteConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[13]
+	mi := &file_proto_connection_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,7 +1034,7 @@ func (x *DeleteConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConnectionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{13}
+	return file_proto_connection_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteConnectionRequest) GetUser() *User {
@@ -918,7 +1060,7 @@ type DeleteConnectionResponse struct {
 func (x *DeleteConnectionResponse) Reset() {
 	*x = DeleteConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[14]
+		mi := &file_proto_connection_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -931,7 +1073,7 @@ func (x *DeleteConnectionResponse) String() string {
 func (*DeleteConnectionResponse) ProtoMessage() {}
 
 func (x *DeleteConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[14]
+	mi := &file_proto_connection_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1086,7 @@ func (x *DeleteConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConnectionResponse.ProtoReflect.Descriptor instead.
 func (*DeleteConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{14}
+	return file_proto_connection_proto_rawDescGZIP(), []int{15}
 }
 
 type RefreshConnectionRequest struct {
@@ -958,7 +1100,7 @@ type RefreshConnectionRequest struct {
 func (x *RefreshConnectionRequest) Reset() {
 	*x = RefreshConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[15]
+		mi := &file_proto_connection_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -971,7 +1113,7 @@ func (x *RefreshConnectionRequest) String() string {
 func (*RefreshConnectionRequest) ProtoMessage() {}
 
 func (x *RefreshConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[15]
+	mi := &file_proto_connection_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1126,7 @@ func (x *RefreshConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshConnectionRequest.ProtoReflect.Descriptor instead.
 func (*RefreshConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{15}
+	return file_proto_connection_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RefreshConnectionRequest) GetUser() *User {
@@ -994,16 +1136,89 @@ func (x *RefreshConnectionRequest) GetUser() *User {
 	return nil
 }
 
+type ConnectionRefreshResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionId string        `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Action       RefreshAction `protobuf:"varint,2,opt,name=action,proto3,enum=pxgrider_proto.RefreshAction" json:"action,omitempty"`
+	Changed      []string      `protobuf:"bytes,3,rep,name=changed,proto3" json:"changed,omitempty"`
+	Error        string        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ConnectionRefreshResult) Reset() {
+	*x = ConnectionRefreshResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionRefreshResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionRefreshResult) ProtoMessage() {}
+
+func (x *ConnectionRefreshResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionRefreshResult.ProtoReflect.Descriptor instead.
+func (*ConnectionRefreshResult) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConnectionRefreshResult) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ConnectionRefreshResult) GetAction() RefreshAction {
+	if x != nil {
+		return x.Action
+	}
+	return RefreshAction_REFRESH_ACTION_UNCHANGED
+}
+
+func (x *ConnectionRefreshResult) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *ConnectionRefreshResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type RefreshConnectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Results []*ConnectionRefreshResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (x *RefreshConnectionResponse) Reset() {
 	*x = RefreshConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[16]
+		mi := &file_proto_connection_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1016,7 +1231,7 @@ func (x *RefreshConnectionResponse) String() string {
 func (*RefreshConnectionResponse) ProtoMessage() {}
 
 func (x *RefreshConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[16]
+	mi := &file_proto_connection_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1244,14 @@ func (x *RefreshConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshConnectionResponse.ProtoReflect.Descriptor instead.
 func (*RefreshConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{16}
+	return file_proto_connection_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefreshConnectionResponse) GetResults() []*ConnectionRefreshResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
 }
 
 type SubscribeConnectionRequest struct {
@@ -1037,16 +1259,17 @@ type SubscribeConnectionRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	Service      string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Topic        string `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	User         *User   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string  `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Service      string  `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Topic        string  `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	Sinks        []*Sink `protobuf:"bytes,5,rep,name=sinks,proto3" json:"sinks,omitempty"`
 }
 
 func (x *SubscribeConnectionRequest) Reset() {
 	*x = SubscribeConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[17]
+		mi := &file_proto_connection_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1059,7 +1282,7 @@ func (x *SubscribeConnectionRequest) String() string {
 func (*SubscribeConnectionRequest) ProtoMessage() {}
 
 func (x *SubscribeConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[17]
+	mi := &file_proto_connection_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1295,7 @@ func (x *SubscribeConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeConnectionRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{17}
+	return file_proto_connection_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SubscribeConnectionRequest) GetUser() *User {
@@ -1103,6 +1326,13 @@ func (x *SubscribeConnectionRequest) GetTopic() string {
 	return ""
 }
 
+func (x *SubscribeConnectionRequest) GetSinks() []*Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
 type SubscribeConnectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1114,7 +1344,7 @@ type SubscribeConnectionResponse struct {
 func (x *SubscribeConnectionResponse) Reset() {
 	*x = SubscribeConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[18]
+		mi := &file_proto_connection_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1127,7 +1357,7 @@ func (x *SubscribeConnectionResponse) String() string {
 func (*SubscribeConnectionResponse) ProtoMessage() {}
 
 func (x *SubscribeConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[18]
+	mi := &file_proto_connection_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +1370,7 @@ func (x *SubscribeConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeConnectionResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{18}
+	return file_proto_connection_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SubscribeConnectionResponse) GetSubscription() *Subscription {
@@ -1150,34 +1380,492 @@ func (x *SubscribeConnectionResponse) GetSubscription() *Subscription {
 	return nil
 }
 
-type GetSubscriptionRequest struct {
+type SetSubscriptionSinksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	Service      string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Topic        string `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	User         *User   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string  `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Service      string  `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Topic        string  `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	Sinks        []*Sink `protobuf:"bytes,5,rep,name=sinks,proto3" json:"sinks,omitempty"`
 }
 
-func (x *GetSubscriptionRequest) Reset() {
-	*x = GetSubscriptionRequest{}
+func (x *SetSubscriptionSinksRequest) Reset() {
+	*x = SetSubscriptionSinksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[19]
+		mi := &file_proto_connection_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubscriptionRequest) String() string {
+func (x *SetSubscriptionSinksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscriptionRequest) ProtoMessage() {}
+func (*SetSubscriptionSinksRequest) ProtoMessage() {}
 
-func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[19]
+func (x *SetSubscriptionSinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubscriptionSinksRequest.ProtoReflect.Descriptor instead.
+func (*SetSubscriptionSinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetSubscriptionSinksRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SetSubscriptionSinksRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *SetSubscriptionSinksRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *SetSubscriptionSinksRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SetSubscriptionSinksRequest) GetSinks() []*Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type SetSubscriptionSinksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *SetSubscriptionSinksResponse) Reset() {
+	*x = SetSubscriptionSinksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSubscriptionSinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubscriptionSinksResponse) ProtoMessage() {}
+
+func (x *SetSubscriptionSinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubscriptionSinksResponse.ProtoReflect.Descriptor instead.
+func (*SetSubscriptionSinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetSubscriptionSinksResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type GetConnectionSinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (x *GetConnectionSinksRequest) Reset() {
+	*x = GetConnectionSinksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectionSinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionSinksRequest) ProtoMessage() {}
+
+func (x *GetConnectionSinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionSinksRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectionSinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetConnectionSinksRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetConnectionSinksRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type GetConnectionSinksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sinks []*Sink `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+}
+
+func (x *GetConnectionSinksResponse) Reset() {
+	*x = GetConnectionSinksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectionSinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionSinksResponse) ProtoMessage() {}
+
+func (x *GetConnectionSinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionSinksResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectionSinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetConnectionSinksResponse) GetSinks() []*Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type SetConnectionSinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         *User   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string  `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Sinks        []*Sink `protobuf:"bytes,3,rep,name=sinks,proto3" json:"sinks,omitempty"`
+}
+
+func (x *SetConnectionSinksRequest) Reset() {
+	*x = SetConnectionSinksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConnectionSinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConnectionSinksRequest) ProtoMessage() {}
+
+func (x *SetConnectionSinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConnectionSinksRequest.ProtoReflect.Descriptor instead.
+func (*SetConnectionSinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetConnectionSinksRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SetConnectionSinksRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *SetConnectionSinksRequest) GetSinks() []*Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type SetConnectionSinksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sinks []*Sink `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+}
+
+func (x *SetConnectionSinksResponse) Reset() {
+	*x = SetConnectionSinksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConnectionSinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConnectionSinksResponse) ProtoMessage() {}
+
+func (x *SetConnectionSinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConnectionSinksResponse.ProtoReflect.Descriptor instead.
+func (*SetConnectionSinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetConnectionSinksResponse) GetSinks() []*Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+// SetConnectionLogLevelRequest levels are trace, debug, info, warn or error, an
+// empty level resets it to the server log level
+type SetConnectionLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	StdoutLevel  string `protobuf:"bytes,3,opt,name=stdout_level,json=stdoutLevel,proto3" json:"stdout_level,omitempty"`
+	DbLevel      string `protobuf:"bytes,4,opt,name=db_level,json=dbLevel,proto3" json:"db_level,omitempty"`
+}
+
+func (x *SetConnectionLogLevelRequest) Reset() {
+	*x = SetConnectionLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConnectionLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConnectionLogLevelRequest) ProtoMessage() {}
+
+func (x *SetConnectionLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConnectionLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetConnectionLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetConnectionLogLevelRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SetConnectionLogLevelRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *SetConnectionLogLevelRequest) GetStdoutLevel() string {
+	if x != nil {
+		return x.StdoutLevel
+	}
+	return ""
+}
+
+func (x *SetConnectionLogLevelRequest) GetDbLevel() string {
+	if x != nil {
+		return x.DbLevel
+	}
+	return ""
+}
+
+type SetConnectionLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogLevel *LogLevel `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+}
+
+func (x *SetConnectionLogLevelResponse) Reset() {
+	*x = SetConnectionLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConnectionLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConnectionLogLevelResponse) ProtoMessage() {}
+
+func (x *SetConnectionLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConnectionLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetConnectionLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_connection_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetConnectionLogLevelResponse) GetLogLevel() *LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return nil
+}
+
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Service      string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Topic        string `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1878,7 @@ func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{19}
+	return file_proto_connection_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetSubscriptionRequest) GetUser() *User {
@@ -1232,7 +1920,7 @@ type GetSubscriptionResponse struct {
 func (x *GetSubscriptionResponse) Reset() {
 	*x = GetSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[20]
+		mi := &file_proto_connection_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1245,7 +1933,7 @@ func (x *GetSubscriptionResponse) String() string {
 func (*GetSubscriptionResponse) ProtoMessage() {}
 
 func (x *GetSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[20]
+	mi := &file_proto_connection_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1946,7 @@ func (x *GetSubscriptionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{20}
+	return file_proto_connection_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetSubscriptionResponse) GetSubscription() *Subscription {
@@ -1282,7 +1970,7 @@ type UnsubscribeConnectionRequest struct {
 func (x *UnsubscribeConnectionRequest) Reset() {
 	*x = UnsubscribeConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[21]
+		mi := &file_proto_connection_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1295,7 +1983,7 @@ func (x *UnsubscribeConnectionRequest) String() string {
 func (*UnsubscribeConnectionRequest) ProtoMessage() {}
 
 func (x *UnsubscribeConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[21]
+	mi := &file_proto_connection_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +1996,7 @@ func (x *UnsubscribeConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsubscribeConnectionRequest.ProtoReflect.Descriptor instead.
 func (*UnsubscribeConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{21}
+	return file_proto_connection_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UnsubscribeConnectionRequest) GetUser() *User {
@@ -1348,7 +2036,7 @@ type UnsubscribeConnectionResponse struct {
 func (x *UnsubscribeConnectionResponse) Reset() {
 	*x = UnsubscribeConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[22]
+		mi := &file_proto_connection_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +2049,7 @@ func (x *UnsubscribeConnectionResponse) String() string {
 func (*UnsubscribeConnectionResponse) ProtoMessage() {}
 
 func (x *UnsubscribeConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[22]
+	mi := &file_proto_connection_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +2062,7 @@ func (x *UnsubscribeConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsubscribeConnectionResponse.ProtoReflect.Descriptor instead.
 func (*UnsubscribeConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{22}
+	return file_proto_connection_proto_rawDescGZIP(), []int{32}
 }
 
 type GetAllSubscriptionsRequest struct {
@@ -1390,7 +2078,7 @@ type GetAllSubscriptionsRequest struct {
 func (x *GetAllSubscriptionsRequest) Reset() {
 	*x = GetAllSubscriptionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[23]
+		mi := &file_proto_connection_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1403,7 +2091,7 @@ func (x *GetAllSubscriptionsRequest) String() string {
 func (*GetAllSubscriptionsRequest) ProtoMessage() {}
 
 func (x *GetAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[23]
+	mi := &file_proto_connection_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +2104,7 @@ func (x *GetAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{23}
+	return file_proto_connection_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetAllSubscriptionsRequest) GetUser() *User {
@@ -1451,7 +2139,7 @@ type GetAllSubscriptionsResponse struct {
 func (x *GetAllSubscriptionsResponse) Reset() {
 	*x = GetAllSubscriptionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[24]
+		mi := &file_proto_connection_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +2152,7 @@ func (x *GetAllSubscriptionsResponse) String() string {
 func (*GetAllSubscriptionsResponse) ProtoMessage() {}
 
 func (x *GetAllSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[24]
+	mi := &file_proto_connection_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +2165,7 @@ func (x *GetAllSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{24}
+	return file_proto_connection_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetAllSubscriptionsResponse) GetSubscriptions() []*Subscription {
@@ -1498,7 +2186,7 @@ type TopicsSlice struct {
 func (x *TopicsSlice) Reset() {
 	*x = TopicsSlice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[25]
+		mi := &file_proto_connection_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1511,7 +2199,7 @@ func (x *TopicsSlice) String() string {
 func (*TopicsSlice) ProtoMessage() {}
 
 func (x *TopicsSlice) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[25]
+	mi := &file_proto_connection_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +2212,7 @@ func (x *TopicsSlice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicsSlice.ProtoReflect.Descriptor instead.
 func (*TopicsSlice) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{25}
+	return file_proto_connection_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *TopicsSlice) GetTopics() []string {
@@ -1547,7 +2235,7 @@ type GetServiceTopicsRequest struct {
 func (x *GetServiceTopicsRequest) Reset() {
 	*x = GetServiceTopicsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[26]
+		mi := &file_proto_connection_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1560,7 +2248,7 @@ func (x *GetServiceTopicsRequest) String() string {
 func (*GetServiceTopicsRequest) ProtoMessage() {}
 
 func (x *GetServiceTopicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[26]
+	mi := &file_proto_connection_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,7 +2261,7 @@ func (x *GetServiceTopicsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceTopicsRequest.ProtoReflect.Descriptor instead.
 func (*GetServiceTopicsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{26}
+	return file_proto_connection_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetServiceTopicsRequest) GetUser() *User {
@@ -1608,7 +2296,7 @@ type GetServiceTopicsResponse struct {
 func (x *GetServiceTopicsResponse) Reset() {
 	*x = GetServiceTopicsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[27]
+		mi := &file_proto_connection_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1621,7 +2309,7 @@ func (x *GetServiceTopicsResponse) String() string {
 func (*GetServiceTopicsResponse) ProtoMessage() {}
 
 func (x *GetServiceTopicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[27]
+	mi := &file_proto_connection_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1634,7 +2322,7 @@ func (x *GetServiceTopicsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceTopicsResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceTopicsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{27}
+	return file_proto_connection_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetServiceTopicsResponse) GetTopics() *TopicsSlice {
@@ -1656,7 +2344,7 @@ type GetConnectionTopicsRequest struct {
 func (x *GetConnectionTopicsRequest) Reset() {
 	*x = GetConnectionTopicsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[28]
+		mi := &file_proto_connection_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +2357,7 @@ func (x *GetConnectionTopicsRequest) String() string {
 func (*GetConnectionTopicsRequest) ProtoMessage() {}
 
 func (x *GetConnectionTopicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[28]
+	mi := &file_proto_connection_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +2370,7 @@ func (x *GetConnectionTopicsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionTopicsRequest.ProtoReflect.Descriptor instead.
 func (*GetConnectionTopicsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{28}
+	return file_proto_connection_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetConnectionTopicsRequest) GetUser() *User {
@@ -1710,7 +2398,7 @@ type GetConnectionTopicsResponse struct {
 func (x *GetConnectionTopicsResponse) Reset() {
 	*x = GetConnectionTopicsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_proto_msgTypes[29]
+		mi := &file_proto_connection_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1723,7 +2411,7 @@ func (x *GetConnectionTopicsResponse) String() string {
 func (*GetConnectionTopicsResponse) ProtoMessage() {}
 
 func (x *GetConnectionTopicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_proto_msgTypes[29]
+	mi := &file_proto_connection_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,7 +2424,7 @@ func (x *GetConnectionTopicsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionTopicsResponse.ProtoReflect.Descriptor instead.
 func (*GetConnectionTopicsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_proto_rawDescGZIP(), []int{29}
+	return file_proto_connection_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetConnectionTopicsResponse) GetTopics() map[string]*TopicsSlice {
@@ -1758,266 +2446,372 @@ var file_proto_connection_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x6f,
 	0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xbd, 0x01, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x61, 0x70, 0x12, 0x51, 0x0a, 0x0d,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x5e, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
-	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x71, 0x0a, 0x0a, 0x44, 0x4e, 0x53, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x25, 0x0a,
-	0x03, 0x64, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x78, 0x67,
-	0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x52,
-	0x03, 0x64, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
-	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x50, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x22, 0xee, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72,
-	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78,
-	0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0a, 0x64, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67,
-	0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x46,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67,
-	0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x9f, 0x03, 0x0a, 0x17,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x69,
+	0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x01, 0x0a, 0x08, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x4d, 0x61, 0x70, 0x12, 0x51, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5e, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x71, 0x0a, 0x0a, 0x44, 0x4e, 0x53,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x3c,
+	0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x8a, 0x04, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x0a, 0x64, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x37, 0x0a,
+	0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x6b, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x32, 0x0a, 0x08, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x64, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x62, 0x22, 0x41, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0x33, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x9f, 0x03, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
+	0x0a, 0x64, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72,
-	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0a, 0x64, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6c,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72,
-	0x65, 0x54, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63,
-	0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x56, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78,
-	0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x05, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
-	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72,
-	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
-	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
-	0x45, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x06, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x36, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x40, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x4e, 0x53, 0x52, 0x03, 0x64, 0x6e,
+	0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x0b, 0x64, 0x6e, 0x73, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x3f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78,
 	0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44,
-	0x4e, 0x53, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x64, 0x6e, 0x73, 0x5f, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x02, 0x63, 0x61, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x02, 0x63, 0x61, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
 	0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
-	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x50, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x64, 0x6e, 0x73, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72,
-	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x69,
-	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x02,
-	0x63, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69,
-	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x02, 0x63, 0x61,
-	0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x17,
+	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a,
-	0x18, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x9b, 0x01, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x5f,
-	0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x97, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69,
-	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67,
-	0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x1c, 0x55, 0x6e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
-	0x61, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
-	0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x18, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xa5,
+	0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5e, 0x0a, 0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73,
+	0x22, 0x5f, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x25, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x53, 0x6c, 0x69, 0x63,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x2a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x60, 0x0a, 0x1c,
+	0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x73,
+	0x69, 0x6e, 0x6b, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x48, 0x0a,
+	0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67,
+	0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6e, 0x6b,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x64, 0x6f, 0x75,
+	0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x62,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x62,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x56, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x78, 0x67, 0x72,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x97, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x1c, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
 	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x53, 0x6c, 0x69, 0x63, 0x65,
-	0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x6b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x1a, 0x56, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
-	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x53, 0x6c,
-	0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x38,
-	0x5a, 0x36, 0x77, 0x77, 0x77, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x69, 0x73,
-	0x63, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6b, 0x75, 0x6d, 0x6f, 0x76, 0x2f, 0x67, 0x6f,
-	0x2d, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x61, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x25, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x6b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x1a, 0x56, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x7f, 0x0a, 0x0d,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x18, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52,
+	0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x44,
+	0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x42, 0x2e, 0x5a,
+	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6b, 0x75, 0x6d,
+	0x6f, 0x76, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70,
+	0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2032,103 +2826,135 @@ func file_proto_connection_proto_rawDescGZIP() []byte {
 	return file_proto_connection_proto_rawDescData
 }
 
-var file_proto_connection_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_proto_connection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_connection_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_proto_connection_proto_goTypes = []interface{}{
-	(*TopicMap)(nil),                      // 0: pxgrider_proto.TopicMap
-	(*DNSDetails)(nil),                    // 1: pxgrider_proto.DNSDetails
-	(*Connection)(nil),                    // 2: pxgrider_proto.Connection
-	(*GetConnectionsRequest)(nil),         // 3: pxgrider_proto.GetConnectionsRequest
-	(*GetConnectionsResponse)(nil),        // 4: pxgrider_proto.GetConnectionsResponse
-	(*GetConnectionsTotalRequest)(nil),    // 5: pxgrider_proto.GetConnectionsTotalRequest
-	(*GetConnectionsTotalResponse)(nil),   // 6: pxgrider_proto.GetConnectionsTotalResponse
-	(*CreateConnectionRequest)(nil),       // 7: pxgrider_proto.CreateConnectionRequest
-	(*CreateConnectionResponse)(nil),      // 8: pxgrider_proto.CreateConnectionResponse
-	(*GetConnectionRequest)(nil),          // 9: pxgrider_proto.GetConnectionRequest
-	(*GetConnectionResponse)(nil),         // 10: pxgrider_proto.GetConnectionResponse
-	(*UpdateConnectionRequest)(nil),       // 11: pxgrider_proto.UpdateConnectionRequest
-	(*UpdateConnectionResponse)(nil),      // 12: pxgrider_proto.UpdateConnectionResponse
-	(*DeleteConnectionRequest)(nil),       // 13: pxgrider_proto.DeleteConnectionRequest
-	(*DeleteConnectionResponse)(nil),      // 14: pxgrider_proto.DeleteConnectionResponse
-	(*RefreshConnectionRequest)(nil),      // 15: pxgrider_proto.RefreshConnectionRequest
-	(*RefreshConnectionResponse)(nil),     // 16: pxgrider_proto.RefreshConnectionResponse
-	(*SubscribeConnectionRequest)(nil),    // 17: pxgrider_proto.SubscribeConnectionRequest
-	(*SubscribeConnectionResponse)(nil),   // 18: pxgrider_proto.SubscribeConnectionResponse
-	(*GetSubscriptionRequest)(nil),        // 19: pxgrider_proto.GetSubscriptionRequest
-	(*GetSubscriptionResponse)(nil),       // 20: pxgrider_proto.GetSubscriptionResponse
-	(*UnsubscribeConnectionRequest)(nil),  // 21: pxgrider_proto.UnsubscribeConnectionRequest
-	(*UnsubscribeConnectionResponse)(nil), // 22: pxgrider_proto.UnsubscribeConnectionResponse
-	(*GetAllSubscriptionsRequest)(nil),    // 23: pxgrider_proto.GetAllSubscriptionsRequest
-	(*GetAllSubscriptionsResponse)(nil),   // 24: pxgrider_proto.GetAllSubscriptionsResponse
-	(*TopicsSlice)(nil),                   // 25: pxgrider_proto.TopicsSlice
-	(*GetServiceTopicsRequest)(nil),       // 26: pxgrider_proto.GetServiceTopicsRequest
-	(*GetServiceTopicsResponse)(nil),      // 27: pxgrider_proto.GetServiceTopicsResponse
-	(*GetConnectionTopicsRequest)(nil),    // 28: pxgrider_proto.GetConnectionTopicsRequest
-	(*GetConnectionTopicsResponse)(nil),   // 29: pxgrider_proto.GetConnectionTopicsResponse
-	nil,                                   // 30: pxgrider_proto.TopicMap.SubscriptionsEntry
-	nil,                                   // 31: pxgrider_proto.GetConnectionTopicsResponse.TopicsEntry
-	(*DNS)(nil),                           // 32: pxgrider_proto.DNS
-	(FamilyPreference)(0),                 // 33: pxgrider_proto.FamilyPreference
-	(*Node)(nil),                          // 34: pxgrider_proto.Node
-	(*Credentials)(nil),                   // 35: pxgrider_proto.Credentials
-	(*User)(nil),                          // 36: pxgrider_proto.User
-	(*NullableString)(nil),                // 37: pxgrider_proto.NullableString
-	(*NullableNodeList)(nil),              // 38: pxgrider_proto.NullableNodeList
-	(*NullableCredentials)(nil),           // 39: pxgrider_proto.NullableCredentials
-	(*NullableDNS)(nil),                   // 40: pxgrider_proto.NullableDNS
-	(*NullableFamilyPreference)(nil),      // 41: pxgrider_proto.NullableFamilyPreference
-	(*NullableBool)(nil),                  // 42: pxgrider_proto.NullableBool
-	(*NullableStringList)(nil),            // 43: pxgrider_proto.NullableStringList
-	(*Subscription)(nil),                  // 44: pxgrider_proto.Subscription
+	(RefreshAction)(0),                    // 0: pxgrider_proto.RefreshAction
+	(*TopicMap)(nil),                      // 1: pxgrider_proto.TopicMap
+	(*DNSDetails)(nil),                    // 2: pxgrider_proto.DNSDetails
+	(*Connection)(nil),                    // 3: pxgrider_proto.Connection
+	(*LogLevel)(nil),                      // 4: pxgrider_proto.LogLevel
+	(*GetConnectionsRequest)(nil),         // 5: pxgrider_proto.GetConnectionsRequest
+	(*GetConnectionsResponse)(nil),        // 6: pxgrider_proto.GetConnectionsResponse
+	(*GetConnectionsTotalRequest)(nil),    // 7: pxgrider_proto.GetConnectionsTotalRequest
+	(*GetConnectionsTotalResponse)(nil),   // 8: pxgrider_proto.GetConnectionsTotalResponse
+	(*CreateConnectionRequest)(nil),       // 9: pxgrider_proto.CreateConnectionRequest
+	(*CreateConnectionResponse)(nil),      // 10: pxgrider_proto.CreateConnectionResponse
+	(*GetConnectionRequest)(nil),          // 11: pxgrider_proto.GetConnectionRequest
+	(*GetConnectionResponse)(nil),         // 12: pxgrider_proto.GetConnectionResponse
+	(*UpdateConnectionRequest)(nil),       // 13: pxgrider_proto.UpdateConnectionRequest
+	(*UpdateConnectionResponse)(nil),      // 14: pxgrider_proto.UpdateConnectionResponse
+	(*DeleteConnectionRequest)(nil),       // 15: pxgrider_proto.DeleteConnectionRequest
+	(*DeleteConnectionResponse)(nil),      // 16: pxgrider_proto.DeleteConnectionResponse
+	(*RefreshConnectionRequest)(nil),      // 17: pxgrider_proto.RefreshConnectionRequest
+	(*ConnectionRefreshResult)(nil),       // 18: pxgrider_proto.ConnectionRefreshResult
+	(*RefreshConnectionResponse)(nil),     // 19: pxgrider_proto.RefreshConnectionResponse
+	(*SubscribeConnectionRequest)(nil),    // 20: pxgrider_proto.SubscribeConnectionRequest
+	(*SubscribeConnectionResponse)(nil),   // 21: pxgrider_proto.SubscribeConnectionResponse
+	(*SetSubscriptionSinksRequest)(nil),   // 22: pxgrider_proto.SetSubscriptionSinksRequest
+	(*SetSubscriptionSinksResponse)(nil),  // 23: pxgrider_proto.SetSubscriptionSinksResponse
+	(*GetConnectionSinksRequest)(nil),     // 24: pxgrider_proto.GetConnectionSinksRequest
+	(*GetConnectionSinksResponse)(nil),    // 25: pxgrider_proto.GetConnectionSinksResponse
+	(*SetConnectionSinksRequest)(nil),     // 26: pxgrider_proto.SetConnectionSinksRequest
+	(*SetConnectionSinksResponse)(nil),    // 27: pxgrider_proto.SetConnectionSinksResponse
+	(*SetConnectionLogLevelRequest)(nil),  // 28: pxgrider_proto.SetConnectionLogLevelRequest
+	(*SetConnectionLogLevelResponse)(nil), // 29: pxgrider_proto.SetConnectionLogLevelResponse
+	(*GetSubscriptionRequest)(nil),        // 30: pxgrider_proto.GetSubscriptionRequest
+	(*GetSubscriptionResponse)(nil),       // 31: pxgrider_proto.GetSubscriptionResponse
+	(*UnsubscribeConnectionRequest)(nil),  // 32: pxgrider_proto.UnsubscribeConnectionRequest
+	(*UnsubscribeConnectionResponse)(nil), // 33: pxgrider_proto.UnsubscribeConnectionResponse
+	(*GetAllSubscriptionsRequest)(nil),    // 34: pxgrider_proto.GetAllSubscriptionsRequest
+	(*GetAllSubscriptionsResponse)(nil),   // 35: pxgrider_proto.GetAllSubscriptionsResponse
+	(*TopicsSlice)(nil),                   // 36: pxgrider_proto.TopicsSlice
+	(*GetServiceTopicsRequest)(nil),       // 37: pxgrider_proto.GetServiceTopicsRequest
+	(*GetServiceTopicsResponse)(nil),      // 38: pxgrider_proto.GetServiceTopicsResponse
+	(*GetConnectionTopicsRequest)(nil),    // 39: pxgrider_proto.GetConnectionTopicsRequest
+	(*GetConnectionTopicsResponse)(nil),   // 40: pxgrider_proto.GetConnectionTopicsResponse
+	nil,                                   // 41: pxgrider_proto.TopicMap.SubscriptionsEntry
+	nil,                                   // 42: pxgrider_proto.GetConnectionTopicsResponse.TopicsEntry
+	(*DNS)(nil),                           // 43: pxgrider_proto.DNS
+	(FamilyPreference)(0),                 // 44: pxgrider_proto.FamilyPreference
+	(*Node)(nil),                          // 45: pxgrider_proto.Node
+	(*Credentials)(nil),                   // 46: pxgrider_proto.Credentials
+	(*User)(nil),                          // 47: pxgrider_proto.User
+	(*Retention)(nil),                     // 48: pxgrider_proto.Retention
+	(*Sink)(nil),                          // 49: pxgrider_proto.Sink
+	(*NullableString)(nil),                // 50: pxgrider_proto.NullableString
+	(*NullableNodeList)(nil),              // 51: pxgrider_proto.NullableNodeList
+	(*NullableCredentials)(nil),           // 52: pxgrider_proto.NullableCredentials
+	(*NullableDNS)(nil),                   // 53: pxgrider_proto.NullableDNS
+	(*NullableFamilyPreference)(nil),      // 54: pxgrider_proto.NullableFamilyPreference
+	(*NullableBool)(nil),                  // 55: pxgrider_proto.NullableBool
+	(*NullableStringList)(nil),            // 56: pxgrider_proto.NullableStringList
+	(*NullableRetention)(nil),             // 57: pxgrider_proto.NullableRetention
+	(*Subscription)(nil),                  // 58: pxgrider_proto.Subscription
 }
 var file_proto_connection_proto_depIdxs = []int32{
-	30, // 0: pxgrider_proto.TopicMap.subscriptions:type_name -> pxgrider_proto.TopicMap.SubscriptionsEntry
-	32, // 1: pxgrider_proto.DNSDetails.dns:type_name -> pxgrider_proto.DNS
-	33, // 2: pxgrider_proto.DNSDetails.strategy:type_name -> pxgrider_proto.FamilyPreference
-	34, // 3: pxgrider_proto.Connection.nodes:type_name -> pxgrider_proto.Node
-	35, // 4: pxgrider_proto.Connection.credentials:type_name -> pxgrider_proto.Credentials
-	36, // 5: pxgrider_proto.Connection.owner:type_name -> pxgrider_proto.User
-	1,  // 6: pxgrider_proto.Connection.dns_details:type_name -> pxgrider_proto.DNSDetails
-	36, // 7: pxgrider_proto.GetConnectionsRequest.user:type_name -> pxgrider_proto.User
-	2,  // 8: pxgrider_proto.GetConnectionsResponse.connections:type_name -> pxgrider_proto.Connection
-	36, // 9: pxgrider_proto.GetConnectionsTotalRequest.user:type_name -> pxgrider_proto.User
-	36, // 10: pxgrider_proto.CreateConnectionRequest.user:type_name -> pxgrider_proto.User
-	34, // 11: pxgrider_proto.CreateConnectionRequest.nodes:type_name -> pxgrider_proto.Node
-	35, // 12: pxgrider_proto.CreateConnectionRequest.credentials:type_name -> pxgrider_proto.Credentials
-	1,  // 13: pxgrider_proto.CreateConnectionRequest.dns_details:type_name -> pxgrider_proto.DNSDetails
-	2,  // 14: pxgrider_proto.CreateConnectionResponse.connection:type_name -> pxgrider_proto.Connection
-	36, // 15: pxgrider_proto.GetConnectionRequest.user:type_name -> pxgrider_proto.User
-	2,  // 16: pxgrider_proto.GetConnectionResponse.connection:type_name -> pxgrider_proto.Connection
-	36, // 17: pxgrider_proto.UpdateConnectionRequest.user:type_name -> pxgrider_proto.User
-	37, // 18: pxgrider_proto.UpdateConnectionRequest.friendly_name:type_name -> pxgrider_proto.NullableString
-	38, // 19: pxgrider_proto.UpdateConnectionRequest.nodes:type_name -> pxgrider_proto.NullableNodeList
-	39, // 20: pxgrider_proto.UpdateConnectionRequest.credentials:type_name -> pxgrider_proto.NullableCredentials
-	37, // 21: pxgrider_proto.UpdateConnectionRequest.description:type_name -> pxgrider_proto.NullableString
-	40, // 22: pxgrider_proto.UpdateConnectionRequest.dns:type_name -> pxgrider_proto.NullableDNS
-	41, // 23: pxgrider_proto.UpdateConnectionRequest.dns_strategy:type_name -> pxgrider_proto.NullableFamilyPreference
-	37, // 24: pxgrider_proto.UpdateConnectionRequest.client_name:type_name -> pxgrider_proto.NullableString
-	37, // 25: pxgrider_proto.UpdateConnectionRequest.owner:type_name -> pxgrider_proto.NullableString
-	42, // 26: pxgrider_proto.UpdateConnectionRequest.insecure_tls:type_name -> pxgrider_proto.NullableBool
-	43, // 27: pxgrider_proto.UpdateConnectionRequest.ca:type_name -> pxgrider_proto.NullableStringList
-	36, // 28: pxgrider_proto.DeleteConnectionRequest.user:type_name -> pxgrider_proto.User
-	36, // 29: pxgrider_proto.RefreshConnectionRequest.user:type_name -> pxgrider_proto.User
-	36, // 30: pxgrider_proto.SubscribeConnectionRequest.user:type_name -> pxgrider_proto.User
-	44, // 31: pxgrider_proto.SubscribeConnectionResponse.subscription:type_name -> pxgrider_proto.Subscription
-	36, // 32: pxgrider_proto.GetSubscriptionRequest.user:type_name -> pxgrider_proto.User
-	44, // 33: pxgrider_proto.GetSubscriptionResponse.subscription:type_name -> pxgrider_proto.Subscription
-	36, // 34: pxgrider_proto.UnsubscribeConnectionRequest.user:type_name -> pxgrider_proto.User
-	36, // 35: pxgrider_proto.GetAllSubscriptionsRequest.user:type_name -> pxgrider_proto.User
-	44, // 36: pxgrider_proto.GetAllSubscriptionsResponse.subscriptions:type_name -> pxgrider_proto.Subscription
-	36, // 37: pxgrider_proto.GetServiceTopicsRequest.user:type_name -> pxgrider_proto.User
-	25, // 38: pxgrider_proto.GetServiceTopicsResponse.topics:type_name -> pxgrider_proto.TopicsSlice
-	36, // 39: pxgrider_proto.GetConnectionTopicsRequest.user:type_name -> pxgrider_proto.User
-	31, // 40: pxgrider_proto.GetConnectionTopicsResponse.topics:type_name -> pxgrider_proto.GetConnectionTopicsResponse.TopicsEntry
-	44, // 41: pxgrider_proto.TopicMap.SubscriptionsEntry.value:type_name -> pxgrider_proto.Subscription
-	25, // 42: pxgrider_proto.GetConnectionTopicsResponse.TopicsEntry.value:type_name -> pxgrider_proto.TopicsSlice
-	43, // [43:43] is the sub-list for method output_type
-	43, // [43:43] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	41, // 0: pxgrider_proto.TopicMap.subscriptions:type_name -> pxgrider_proto.TopicMap.SubscriptionsEntry
+	43, // 1: pxgrider_proto.DNSDetails.dns:type_name -> pxgrider_proto.DNS
+	44, // 2: pxgrider_proto.DNSDetails.strategy:type_name -> pxgrider_proto.FamilyPreference
+	45, // 3: pxgrider_proto.Connection.nodes:type_name -> pxgrider_proto.Node
+	46, // 4: pxgrider_proto.Connection.credentials:type_name -> pxgrider_proto.Credentials
+	47, // 5: pxgrider_proto.Connection.owner:type_name -> pxgrider_proto.User
+	2,  // 6: pxgrider_proto.Connection.dns_details:type_name -> pxgrider_proto.DNSDetails
+	48, // 7: pxgrider_proto.Connection.retention:type_name -> pxgrider_proto.Retention
+	49, // 8: pxgrider_proto.Connection.sinks:type_name -> pxgrider_proto.Sink
+	4,  // 9: pxgrider_proto.Connection.log_level:type_name -> pxgrider_proto.LogLevel
+	47, // 10: pxgrider_proto.GetConnectionsRequest.user:type_name -> pxgrider_proto.User
+	3,  // 11: pxgrider_proto.GetConnectionsResponse.connections:type_name -> pxgrider_proto.Connection
+	47, // 12: pxgrider_proto.GetConnectionsTotalRequest.user:type_name -> pxgrider_proto.User
+	47, // 13: pxgrider_proto.CreateConnectionRequest.user:type_name -> pxgrider_proto.User
+	45, // 14: pxgrider_proto.CreateConnectionRequest.nodes:type_name -> pxgrider_proto.Node
+	46, // 15: pxgrider_proto.CreateConnectionRequest.credentials:type_name -> pxgrider_proto.Credentials
+	2,  // 16: pxgrider_proto.CreateConnectionRequest.dns_details:type_name -> pxgrider_proto.DNSDetails
+	3,  // 17: pxgrider_proto.CreateConnectionResponse.connection:type_name -> pxgrider_proto.Connection
+	47, // 18: pxgrider_proto.GetConnectionRequest.user:type_name -> pxgrider_proto.User
+	3,  // 19: pxgrider_proto.GetConnectionResponse.connection:type_name -> pxgrider_proto.Connection
+	47, // 20: pxgrider_proto.UpdateConnectionRequest.user:type_name -> pxgrider_proto.User
+	50, // 21: pxgrider_proto.UpdateConnectionRequest.friendly_name:type_name -> pxgrider_proto.NullableString
+	51, // 22: pxgrider_proto.UpdateConnectionRequest.nodes:type_name -> pxgrider_proto.NullableNodeList
+	52, // 23: pxgrider_proto.UpdateConnectionRequest.credentials:type_name -> pxgrider_proto.NullableCredentials
+	50, // 24: pxgrider_proto.UpdateConnectionRequest.description:type_name -> pxgrider_proto.NullableString
+	53, // 25: pxgrider_proto.UpdateConnectionRequest.dns:type_name -> pxgrider_proto.NullableDNS
+	54, // 26: pxgrider_proto.UpdateConnectionRequest.dns_strategy:type_name -> pxgrider_proto.NullableFamilyPreference
+	50, // 27: pxgrider_proto.UpdateConnectionRequest.client_name:type_name -> pxgrider_proto.NullableString
+	50, // 28: pxgrider_proto.UpdateConnectionRequest.owner:type_name -> pxgrider_proto.NullableString
+	55, // 29: pxgrider_proto.UpdateConnectionRequest.insecure_tls:type_name -> pxgrider_proto.NullableBool
+	56, // 30: pxgrider_proto.UpdateConnectionRequest.ca:type_name -> pxgrider_proto.NullableStringList
+	57, // 31: pxgrider_proto.UpdateConnectionRequest.retention:type_name -> pxgrider_proto.NullableRetention
+	47, // 32: pxgrider_proto.DeleteConnectionRequest.user:type_name -> pxgrider_proto.User
+	47, // 33: pxgrider_proto.RefreshConnectionRequest.user:type_name -> pxgrider_proto.User
+	0,  // 34: pxgrider_proto.ConnectionRefreshResult.action:type_name -> pxgrider_proto.RefreshAction
+	18, // 35: pxgrider_proto.RefreshConnectionResponse.results:type_name -> pxgrider_proto.ConnectionRefreshResult
+	47, // 36: pxgrider_proto.SubscribeConnectionRequest.user:type_name -> pxgrider_proto.User
+	49, // 37: pxgrider_proto.SubscribeConnectionRequest.sinks:type_name -> pxgrider_proto.Sink
+	58, // 38: pxgrider_proto.SubscribeConnectionResponse.subscription:type_name -> pxgrider_proto.Subscription
+	47, // 39: pxgrider_proto.SetSubscriptionSinksRequest.user:type_name -> pxgrider_proto.User
+	49, // 40: pxgrider_proto.SetSubscriptionSinksRequest.sinks:type_name -> pxgrider_proto.Sink
+	58, // 41: pxgrider_proto.SetSubscriptionSinksResponse.subscription:type_name -> pxgrider_proto.Subscription
+	47, // 42: pxgrider_proto.GetConnectionSinksRequest.user:type_name -> pxgrider_proto.User
+	49, // 43: pxgrider_proto.GetConnectionSinksResponse.sinks:type_name -> pxgrider_proto.Sink
+	47, // 44: pxgrider_proto.SetConnectionSinksRequest.user:type_name -> pxgrider_proto.User
+	49, // 45: pxgrider_proto.SetConnectionSinksRequest.sinks:type_name -> pxgrider_proto.Sink
+	49, // 46: pxgrider_proto.SetConnectionSinksResponse.sinks:type_name -> pxgrider_proto.Sink
+	47, // 47: pxgrider_proto.SetConnectionLogLevelRequest.user:type_name -> pxgrider_proto.User
+	4,  // 48: pxgrider_proto.SetConnectionLogLevelResponse.log_level:type_name -> pxgrider_proto.LogLevel
+	47, // 49: pxgrider_proto.GetSubscriptionRequest.user:type_name -> pxgrider_proto.User
+	58, // 50: pxgrider_proto.GetSubscriptionResponse.subscription:type_name -> pxgrider_proto.Subscription
+	47, // 51: pxgrider_proto.UnsubscribeConnectionRequest.user:type_name -> pxgrider_proto.User
+	47, // 52: pxgrider_proto.GetAllSubscriptionsRequest.user:type_name -> pxgrider_proto.User
+	58, // 53: pxgrider_proto.GetAllSubscriptionsResponse.subscriptions:type_name -> pxgrider_proto.Subscription
+	47, // 54: pxgrider_proto.GetServiceTopicsRequest.user:type_name -> pxgrider_proto.User
+	36, // 55: pxgrider_proto.GetServiceTopicsResponse.topics:type_name -> pxgrider_proto.TopicsSlice
+	47, // 56: pxgrider_proto.GetConnectionTopicsRequest.user:type_name -> pxgrider_proto.User
+	42, // 57: pxgrider_proto.GetConnectionTopicsResponse.topics:type_name -> pxgrider_proto.GetConnectionTopicsResponse.TopicsEntry
+	58, // 58: pxgrider_proto.TopicMap.SubscriptionsEntry.value:type_name -> pxgrider_proto.Subscription
+	36, // 59: pxgrider_proto.GetConnectionTopicsResponse.TopicsEntry.value:type_name -> pxgrider_proto.TopicsSlice
+	60, // [60:60] is the sub-list for method output_type
+	60, // [60:60] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_proto_connection_proto_init() }
@@ -2142,6 +2968,8 @@ func file_proto_connection_proto_init() {
 	file_proto_credentials_proto_init()
 	file_proto_node_proto_init()
 	file_proto_nullables_proto_init()
+	file_proto_retention_proto_init()
+	file_proto_sinks_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_proto_connection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopicMap); i {
@@ -2180,7 +3008,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionsRequest); i {
+			switch v := v.(*LogLevel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2192,7 +3020,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionsResponse); i {
+			switch v := v.(*GetConnectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2204,7 +3032,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionsTotalRequest); i {
+			switch v := v.(*GetConnectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2216,7 +3044,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionsTotalResponse); i {
+			switch v := v.(*GetConnectionsTotalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2228,7 +3056,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateConnectionRequest); i {
+			switch v := v.(*GetConnectionsTotalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2240,7 +3068,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateConnectionResponse); i {
+			switch v := v.(*CreateConnectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2252,7 +3080,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionRequest); i {
+			switch v := v.(*CreateConnectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2264,7 +3092,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionResponse); i {
+			switch v := v.(*GetConnectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2276,7 +3104,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateConnectionRequest); i {
+			switch v := v.(*GetConnectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2288,7 +3116,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateConnectionResponse); i {
+			switch v := v.(*UpdateConnectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2300,7 +3128,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteConnectionRequest); i {
+			switch v := v.(*UpdateConnectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2312,7 +3140,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteConnectionResponse); i {
+			switch v := v.(*DeleteConnectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2324,7 +3152,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshConnectionRequest); i {
+			switch v := v.(*DeleteConnectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2336,7 +3164,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshConnectionResponse); i {
+			switch v := v.(*RefreshConnectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2348,7 +3176,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeConnectionRequest); i {
+			switch v := v.(*ConnectionRefreshResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2360,7 +3188,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeConnectionResponse); i {
+			switch v := v.(*RefreshConnectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2372,7 +3200,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscriptionRequest); i {
+			switch v := v.(*SubscribeConnectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2384,7 +3212,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscriptionResponse); i {
+			switch v := v.(*SubscribeConnectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2396,7 +3224,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeConnectionRequest); i {
+			switch v := v.(*SetSubscriptionSinksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2408,7 +3236,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeConnectionResponse); i {
+			switch v := v.(*SetSubscriptionSinksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2420,7 +3248,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllSubscriptionsRequest); i {
+			switch v := v.(*GetConnectionSinksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2432,7 +3260,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllSubscriptionsResponse); i {
+			switch v := v.(*GetConnectionSinksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2444,7 +3272,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicsSlice); i {
+			switch v := v.(*SetConnectionSinksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2456,7 +3284,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceTopicsRequest); i {
+			switch v := v.(*SetConnectionSinksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2468,7 +3296,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceTopicsResponse); i {
+			switch v := v.(*SetConnectionLogLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2480,7 +3308,7 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionTopicsRequest); i {
+			switch v := v.(*SetConnectionLogLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2492,6 +3320,126 @@ func file_proto_connection_proto_init() {
 			}
 		}
 		file_proto_connection_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeConnectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicsSlice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceTopicsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceTopicsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConnectionTopicsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConnectionTopicsResponse); i {
 			case 0:
 				return &v.state
@@ -2509,13 +3457,14 @@ func file_proto_connection_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_connection_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   32,
+			NumEnums:      1,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_connection_proto_goTypes,
 		DependencyIndexes: file_proto_connection_proto_depIdxs,
+		EnumInfos:         file_proto_connection_proto_enumTypes,
 		MessageInfos:      file_proto_connection_proto_msgTypes,
 	}.Build()
 	File_proto_connection_proto = out.File
@@ -405,11 +405,10 @@ var file_proto_fqdn_proto_rawDesc = []byte{
 	0x63, 0x65, 0x5f, 0x49, 0x50, 0x76, 0x36, 0x41, 0x6e, 0x64, 0x49, 0x50, 0x76, 0x34, 0x10, 0x03,
 	0x2a, 0x26, 0x0a, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e,
 	0x45, 0x54, 0x5f, 0x49, 0x50, 0x76, 0x34, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x45,
-	0x54, 0x5f, 0x49, 0x50, 0x76, 0x36, 0x10, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x77, 0x77, 0x77, 0x2d,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x69, 0x73, 0x63, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x76, 0x6b, 0x75, 0x6d, 0x6f, 0x76, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x78, 0x67, 0x72, 0x69,
-	0x64, 0x65, 0x72, 0x2f, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x5f, 0x49, 0x50, 0x76, 0x36, 0x10, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6b, 0x75, 0x6d, 0x6f, 0x76, 0x2f, 0x67, 0x6f,
+	0x2d, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_NDJSON  ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV     ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_PARQUET ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_NDJSON",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_NDJSON":  0,
+		"EXPORT_FORMAT_CSV":     1,
+		"EXPORT_FORMAT_PARQUET": 2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_connection_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_connection_messages_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{0}
+}
+
 type ConnectionMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -108,21 +157,180 @@ func (x *ConnectionMessage) GetViewed() bool {
 	return false
 }
 
+type MessageFieldFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// dot separated path inside the message, e.g. "sessions.macAddress"
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MessageFieldFilter) Reset() {
+	*x = MessageFieldFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_messages_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageFieldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageFieldFilter) ProtoMessage() {}
+
+func (x *MessageFieldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_messages_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageFieldFilter.ProtoReflect.Descriptor instead.
+func (*MessageFieldFilter) Descriptor() ([]byte, []int) {
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageFieldFilter) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MessageFieldFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type MessagesFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []string               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Viewed *bool                  `protobuf:"varint,4,opt,name=viewed,proto3,oneof" json:"viewed,omitempty"`
+	// all fields must match, values are compared as text
+	Fields []*MessageFieldFilter `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	// JSON document the message must contain, e.g. {"state":"STARTED"}
+	Contains string `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	// JMESPath expression, messages for which it yields a falsy value are skipped
+	Jmespath string `protobuf:"bytes,7,opt,name=jmespath,proto3" json:"jmespath,omitempty"`
+}
+
+func (x *MessagesFilter) Reset() {
+	*x = MessagesFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_messages_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesFilter) ProtoMessage() {}
+
+func (x *MessagesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_messages_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesFilter.ProtoReflect.Descriptor instead.
+func (*MessagesFilter) Descriptor() ([]byte, []int) {
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessagesFilter) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *MessagesFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *MessagesFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *MessagesFilter) GetViewed() bool {
+	if x != nil && x.Viewed != nil {
+		return *x.Viewed
+	}
+	return false
+}
+
+func (x *MessagesFilter) GetFields() []*MessageFieldFilter {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *MessagesFilter) GetContains() string {
+	if x != nil {
+		return x.Contains
+	}
+	return ""
+}
+
+func (x *MessagesFilter) GetJmespath() string {
+	if x != nil {
+		return x.Jmespath
+	}
+	return ""
+}
+
 type GetConnectionMessagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	Limit        int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset       int64  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	User         *User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string          `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Limit        int64           `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset       int64           `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filter       *MessagesFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// next_cursor of the previous page, offset is ignored if set
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// estimate the total instead of counting every matching row
+	ApproximateTotal bool `protobuf:"varint,7,opt,name=approximate_total,json=approximateTotal,proto3" json:"approximate_total,omitempty"`
 }
 
 func (x *GetConnectionMessagesRequest) Reset() {
 	*x = GetConnectionMessagesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_messages_proto_msgTypes[1]
+		mi := &file_proto_connection_messages_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -135,7 +343,7 @@ func (x *GetConnectionMessagesRequest) String() string {
 func (*GetConnectionMessagesRequest) ProtoMessage() {}
 
 func (x *GetConnectionMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_messages_proto_msgTypes[1]
+	mi := &file_proto_connection_messages_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +356,7 @@ func (x *GetConnectionMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionMessagesRequest.ProtoReflect.Descriptor instead.
 func (*GetConnectionMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_messages_proto_rawDescGZIP(), []int{1}
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetConnectionMessagesRequest) GetUser() *User {
@@ -179,6 +387,27 @@ func (x *GetConnectionMessagesRequest) GetOffset() int64 {
 	return 0
 }
 
+func (x *GetConnectionMessagesRequest) GetFilter() *MessagesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetConnectionMessagesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetConnectionMessagesRequest) GetApproximateTotal() bool {
+	if x != nil {
+		return x.ApproximateTotal
+	}
+	return false
+}
+
 type GetConnectionMessagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -188,12 +417,17 @@ type GetConnectionMessagesResponse struct {
 	Total    int64                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Limit    int64                `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset   int64                `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// set if the total is estimated or, with a JMESPath filter, counts only the
+	// matches among the newest scanned messages
+	TotalApproximate bool `protobuf:"varint,6,opt,name=total_approximate,json=totalApproximate,proto3" json:"total_approximate,omitempty"`
 }
 
 func (x *GetConnectionMessagesResponse) Reset() {
 	*x = GetConnectionMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_messages_proto_msgTypes[2]
+		mi := &file_proto_connection_messages_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -206,7 +440,7 @@ func (x *GetConnectionMessagesResponse) String() string {
 func (*GetConnectionMessagesResponse) ProtoMessage() {}
 
 func (x *GetConnectionMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_messages_proto_msgTypes[2]
+	mi := &file_proto_connection_messages_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +453,7 @@ func (x *GetConnectionMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectionMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetConnectionMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_messages_proto_rawDescGZIP(), []int{2}
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetConnectionMessagesResponse) GetMessages() []*ConnectionMessage {
@@ -250,6 +484,20 @@ func (x *GetConnectionMessagesResponse) GetOffset() int64 {
 	return 0
 }
 
+func (x *GetConnectionMessagesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetConnectionMessagesResponse) GetTotalApproximate() bool {
+	if x != nil {
+		return x.TotalApproximate
+	}
+	return false
+}
+
 type MarkConnectionMessagesAsReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -263,7 +511,7 @@ type MarkConnectionMessagesAsReadRequest struct {
 func (x *MarkConnectionMessagesAsReadRequest) Reset() {
 	*x = MarkConnectionMessagesAsReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_messages_proto_msgTypes[3]
+		mi := &file_proto_connection_messages_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -276,7 +524,7 @@ func (x *MarkConnectionMessagesAsReadRequest) String() string {
 func (*MarkConnectionMessagesAsReadRequest) ProtoMessage() {}
 
 func (x *MarkConnectionMessagesAsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_messages_proto_msgTypes[3]
+	mi := &file_proto_connection_messages_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +537,7 @@ func (x *MarkConnectionMessagesAsReadRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use MarkConnectionMessagesAsReadRequest.ProtoReflect.Descriptor instead.
 func (*MarkConnectionMessagesAsReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_messages_proto_rawDescGZIP(), []int{3}
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MarkConnectionMessagesAsReadRequest) GetUser() *User {
@@ -322,7 +570,7 @@ type MarkConnectionMessagesAsReadResponse struct {
 func (x *MarkConnectionMessagesAsReadResponse) Reset() {
 	*x = MarkConnectionMessagesAsReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_messages_proto_msgTypes[4]
+		mi := &file_proto_connection_messages_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +583,7 @@ func (x *MarkConnectionMessagesAsReadResponse) String() string {
 func (*MarkConnectionMessagesAsReadResponse) ProtoMessage() {}
 
 func (x *MarkConnectionMessagesAsReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_messages_proto_msgTypes[4]
+	mi := &file_proto_connection_messages_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +596,7 @@ func (x *MarkConnectionMessagesAsReadResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use MarkConnectionMessagesAsReadResponse.ProtoReflect.Descriptor instead.
 func (*MarkConnectionMessagesAsReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_messages_proto_rawDescGZIP(), []int{4}
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{6}
 }
 
 type MessageIDs struct {
@@ -362,7 +610,7 @@ type MessageIDs struct {
 func (x *MessageIDs) Reset() {
 	*x = MessageIDs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_messages_proto_msgTypes[5]
+		mi := &file_proto_connection_messages_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -375,7 +623,7 @@ func (x *MessageIDs) String() string {
 func (*MessageIDs) ProtoMessage() {}
 
 func (x *MessageIDs) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_messages_proto_msgTypes[5]
+	mi := &file_proto_connection_messages_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +636,7 @@ func (x *MessageIDs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageIDs.ProtoReflect.Descriptor instead.
 func (*MessageIDs) Descriptor() ([]byte, []int) {
-	return file_proto_connection_messages_proto_rawDescGZIP(), []int{5}
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MessageIDs) GetIds() []int64 {
@@ -406,7 +654,6 @@ type DeleteConnectionMessagesRequest struct {
 	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 	// Types that are assignable to What:
-	//
 	//	*DeleteConnectionMessagesRequest_MessageIds
 	//	*DeleteConnectionMessagesRequest_All
 	What isDeleteConnectionMessagesRequest_What `protobuf_oneof:"what"`
@@ -415,7 +662,7 @@ type DeleteConnectionMessagesRequest struct {
 func (x *DeleteConnectionMessagesRequest) Reset() {
 	*x = DeleteConnectionMessagesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_messages_proto_msgTypes[6]
+		mi := &file_proto_connection_messages_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -428,7 +675,7 @@ func (x *DeleteConnectionMessagesRequest) String() string {
 func (*DeleteConnectionMessagesRequest) ProtoMessage() {}
 
 func (x *DeleteConnectionMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_messages_proto_msgTypes[6]
+	mi := &file_proto_connection_messages_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +688,7 @@ func (x *DeleteConnectionMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConnectionMessagesRequest.ProtoReflect.Descriptor instead.
 func (*DeleteConnectionMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_connection_messages_proto_rawDescGZIP(), []int{6}
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteConnectionMessagesRequest) GetUser() *User {
@@ -506,7 +753,7 @@ type DeleteConnectionMessagesResponse struct {
 func (x *DeleteConnectionMessagesResponse) Reset() {
 	*x = DeleteConnectionMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_connection_messages_proto_msgTypes[7]
+		mi := &file_proto_connection_messages_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +766,7 @@ func (x *DeleteConnectionMessagesResponse) String() string {
 func (*DeleteConnectionMessagesResponse) ProtoMessage() {}
 
 func (x *DeleteConnectionMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_connection_messages_proto_msgTypes[7]
+	mi := &file_proto_connection_messages_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +779,7 @@ func (x *DeleteConnectionMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConnectionMessagesResponse.ProtoReflect.Descriptor instead.
 func (*DeleteConnectionMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_connection_messages_proto_rawDescGZIP(), []int{7}
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteConnectionMessagesResponse) GetDeleted() int64 {
@@ -542,6 +789,278 @@ func (x *DeleteConnectionMessagesResponse) GetDeleted() int64 {
 	return 0
 }
 
+type StreamConnectionMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Service      string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Topic        string `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	ResumeFromId int64  `protobuf:"varint,5,opt,name=resume_from_id,json=resumeFromId,proto3" json:"resume_from_id,omitempty"`
+}
+
+func (x *StreamConnectionMessagesRequest) Reset() {
+	*x = StreamConnectionMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_messages_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamConnectionMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConnectionMessagesRequest) ProtoMessage() {}
+
+func (x *StreamConnectionMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_messages_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConnectionMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamConnectionMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamConnectionMessagesRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *StreamConnectionMessagesRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *StreamConnectionMessagesRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *StreamConnectionMessagesRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *StreamConnectionMessagesRequest) GetResumeFromId() int64 {
+	if x != nil {
+		return x.ResumeFromId
+	}
+	return 0
+}
+
+type StreamConnectionMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *ConnectionMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Service string             `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *StreamConnectionMessagesResponse) Reset() {
+	*x = StreamConnectionMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_messages_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamConnectionMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConnectionMessagesResponse) ProtoMessage() {}
+
+func (x *StreamConnectionMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_messages_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConnectionMessagesResponse.ProtoReflect.Descriptor instead.
+func (*StreamConnectionMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamConnectionMessagesResponse) GetMessage() *ConnectionMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *StreamConnectionMessagesResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type ExportConnectionMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         *User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ConnectionId string          `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Filter       *MessagesFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format       ExportFormat    `protobuf:"varint,4,opt,name=format,proto3,enum=pxgrider_proto.ExportFormat" json:"format,omitempty"`
+	// flattened message fields exported as CSV columns, e.g. "macAddress",
+	// taken from the first rows if empty
+	CsvColumns []string `protobuf:"bytes,5,rep,name=csv_columns,json=csvColumns,proto3" json:"csv_columns,omitempty"`
+}
+
+func (x *ExportConnectionMessagesRequest) Reset() {
+	*x = ExportConnectionMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_messages_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportConnectionMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConnectionMessagesRequest) ProtoMessage() {}
+
+func (x *ExportConnectionMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_messages_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConnectionMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ExportConnectionMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportConnectionMessagesRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ExportConnectionMessagesRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ExportConnectionMessagesRequest) GetFilter() *MessagesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportConnectionMessagesRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_NDJSON
+}
+
+func (x *ExportConnectionMessagesRequest) GetCsvColumns() []string {
+	if x != nil {
+		return x.CsvColumns
+	}
+	return nil
+}
+
+type ExportConnectionMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// next part of the exported file
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// number of exported messages, set on the last response only
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ExportConnectionMessagesResponse) Reset() {
+	*x = ExportConnectionMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_connection_messages_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportConnectionMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConnectionMessagesResponse) ProtoMessage() {}
+
+func (x *ExportConnectionMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_connection_messages_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConnectionMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ExportConnectionMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_connection_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportConnectionMessagesResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportConnectionMessagesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_proto_connection_messages_proto protoreflect.FileDescriptor
 
 var file_proto_connection_messages_proto_rawDesc = []byte{
@@ -563,17 +1082,47 @@ var file_proto_connection_messages_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06,
 	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x69,
-	0x65, 0x77, 0x65, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x65, 0x77, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x06, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x74, 0x68, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x22, 0x98, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0xf0, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
@@ -583,42 +1132,93 @@ var file_proto_connection_messages_proto_rawDesc = []byte{
 	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
 	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
 	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x23, 0x4d, 0x61, 0x72, 0x6b,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x23, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78,
+	0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x26, 0x0a,
+	0x24, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22,
-	0x26, 0x0a, 0x24, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72,
-	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x03, 0x61, 0x6c, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x42, 0x06, 0x0a,
-	0x04, 0x77, 0x68, 0x61, 0x74, 0x22, 0x3c, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x42, 0x38, 0x5a, 0x36, 0x77, 0x77, 0x77, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x69, 0x73, 0x63, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6b, 0x75, 0x6d,
-	0x6f, 0x76, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70,
-	0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x77,
+	0x68, 0x61, 0x74, 0x22, 0x3c, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x20, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x1f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x73, 0x76, 0x5f, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x73, 0x76,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x4e, 0x0a, 0x20, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2a, 0x5a, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45,
+	0x54, 0x10, 0x02, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x76, 0x6b, 0x75, 0x6d, 0x6f, 0x76, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x78, 0x67, 0x72,
+	0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -633,31 +1233,48 @@ func file_proto_connection_messages_proto_rawDescGZIP() []byte {
 	return file_proto_connection_messages_proto_rawDescData
 }
 
-var file_proto_connection_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_connection_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_connection_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_connection_messages_proto_goTypes = []interface{}{
-	(*ConnectionMessage)(nil),                    // 0: pxgrider_proto.ConnectionMessage
-	(*GetConnectionMessagesRequest)(nil),         // 1: pxgrider_proto.GetConnectionMessagesRequest
-	(*GetConnectionMessagesResponse)(nil),        // 2: pxgrider_proto.GetConnectionMessagesResponse
-	(*MarkConnectionMessagesAsReadRequest)(nil),  // 3: pxgrider_proto.MarkConnectionMessagesAsReadRequest
-	(*MarkConnectionMessagesAsReadResponse)(nil), // 4: pxgrider_proto.MarkConnectionMessagesAsReadResponse
-	(*MessageIDs)(nil),                           // 5: pxgrider_proto.MessageIDs
-	(*DeleteConnectionMessagesRequest)(nil),      // 6: pxgrider_proto.DeleteConnectionMessagesRequest
-	(*DeleteConnectionMessagesResponse)(nil),     // 7: pxgrider_proto.DeleteConnectionMessagesResponse
-	(*timestamppb.Timestamp)(nil),                // 8: google.protobuf.Timestamp
-	(*User)(nil),                                 // 9: pxgrider_proto.User
+	(ExportFormat)(0),                            // 0: pxgrider_proto.ExportFormat
+	(*ConnectionMessage)(nil),                    // 1: pxgrider_proto.ConnectionMessage
+	(*MessageFieldFilter)(nil),                   // 2: pxgrider_proto.MessageFieldFilter
+	(*MessagesFilter)(nil),                       // 3: pxgrider_proto.MessagesFilter
+	(*GetConnectionMessagesRequest)(nil),         // 4: pxgrider_proto.GetConnectionMessagesRequest
+	(*GetConnectionMessagesResponse)(nil),        // 5: pxgrider_proto.GetConnectionMessagesResponse
+	(*MarkConnectionMessagesAsReadRequest)(nil),  // 6: pxgrider_proto.MarkConnectionMessagesAsReadRequest
+	(*MarkConnectionMessagesAsReadResponse)(nil), // 7: pxgrider_proto.MarkConnectionMessagesAsReadResponse
+	(*MessageIDs)(nil),                           // 8: pxgrider_proto.MessageIDs
+	(*DeleteConnectionMessagesRequest)(nil),      // 9: pxgrider_proto.DeleteConnectionMessagesRequest
+	(*DeleteConnectionMessagesResponse)(nil),     // 10: pxgrider_proto.DeleteConnectionMessagesResponse
+	(*StreamConnectionMessagesRequest)(nil),      // 11: pxgrider_proto.StreamConnectionMessagesRequest
+	(*StreamConnectionMessagesResponse)(nil),     // 12: pxgrider_proto.StreamConnectionMessagesResponse
+	(*ExportConnectionMessagesRequest)(nil),      // 13: pxgrider_proto.ExportConnectionMessagesRequest
+	(*ExportConnectionMessagesResponse)(nil),     // 14: pxgrider_proto.ExportConnectionMessagesResponse
+	(*timestamppb.Timestamp)(nil),                // 15: google.protobuf.Timestamp
+	(*User)(nil),                                 // 16: pxgrider_proto.User
 }
 var file_proto_connection_messages_proto_depIdxs = []int32{
-	8, // 0: pxgrider_proto.ConnectionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	9, // 1: pxgrider_proto.GetConnectionMessagesRequest.user:type_name -> pxgrider_proto.User
-	0, // 2: pxgrider_proto.GetConnectionMessagesResponse.messages:type_name -> pxgrider_proto.ConnectionMessage
-	9, // 3: pxgrider_proto.MarkConnectionMessagesAsReadRequest.user:type_name -> pxgrider_proto.User
-	9, // 4: pxgrider_proto.DeleteConnectionMessagesRequest.user:type_name -> pxgrider_proto.User
-	5, // 5: pxgrider_proto.DeleteConnectionMessagesRequest.message_ids:type_name -> pxgrider_proto.MessageIDs
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	15, // 0: pxgrider_proto.ConnectionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	15, // 1: pxgrider_proto.MessagesFilter.from:type_name -> google.protobuf.Timestamp
+	15, // 2: pxgrider_proto.MessagesFilter.to:type_name -> google.protobuf.Timestamp
+	2,  // 3: pxgrider_proto.MessagesFilter.fields:type_name -> pxgrider_proto.MessageFieldFilter
+	16, // 4: pxgrider_proto.GetConnectionMessagesRequest.user:type_name -> pxgrider_proto.User
+	3,  // 5: pxgrider_proto.GetConnectionMessagesRequest.filter:type_name -> pxgrider_proto.MessagesFilter
+	1,  // 6: pxgrider_proto.GetConnectionMessagesResponse.messages:type_name -> pxgrider_proto.ConnectionMessage
+	16, // 7: pxgrider_proto.MarkConnectionMessagesAsReadRequest.user:type_name -> pxgrider_proto.User
+	16, // 8: pxgrider_proto.DeleteConnectionMessagesRequest.user:type_name -> pxgrider_proto.User
+	8,  // 9: pxgrider_proto.DeleteConnectionMessagesRequest.message_ids:type_name -> pxgrider_proto.MessageIDs
+	16, // 10: pxgrider_proto.StreamConnectionMessagesRequest.user:type_name -> pxgrider_proto.User
+	1,  // 11: pxgrider_proto.StreamConnectionMessagesResponse.message:type_name -> pxgrider_proto.ConnectionMessage
+	16, // 12: pxgrider_proto.ExportConnectionMessagesRequest.user:type_name -> pxgrider_proto.User
+	3,  // 13: pxgrider_proto.ExportConnectionMessagesRequest.filter:type_name -> pxgrider_proto.MessagesFilter
+	0,  // 14: pxgrider_proto.ExportConnectionMessagesRequest.format:type_name -> pxgrider_proto.ExportFormat
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_connection_messages_proto_init() }
@@ -680,7 +1297,7 @@ func file_proto_connection_messages_proto_init() {
 			}
 		}
 		file_proto_connection_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionMessagesRequest); i {
+			switch v := v.(*MessageFieldFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -692,7 +1309,7 @@ func file_proto_connection_messages_proto_init() {
 			}
 		}
 		file_proto_connection_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConnectionMessagesResponse); i {
+			switch v := v.(*MessagesFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -704,7 +1321,7 @@ func file_proto_connection_messages_proto_init() {
 			}
 		}
 		file_proto_connection_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkConnectionMessagesAsReadRequest); i {
+			switch v := v.(*GetConnectionMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -716,7 +1333,7 @@ func file_proto_connection_messages_proto_init() {
 			}
 		}
 		file_proto_connection_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkConnectionMessagesAsReadResponse); i {
+			switch v := v.(*GetConnectionMessagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -728,7 +1345,7 @@ func file_proto_connection_messages_proto_init() {
 			}
 		}
 		file_proto_connection_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageIDs); i {
+			switch v := v.(*MarkConnectionMessagesAsReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -740,7 +1357,7 @@ func file_proto_connection_messages_proto_init() {
 			}
 		}
 		file_proto_connection_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteConnectionMessagesRequest); i {
+			switch v := v.(*MarkConnectionMessagesAsReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -752,6 +1369,30 @@ func file_proto_connection_messages_proto_init() {
 			}
 		}
 		file_proto_connection_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageIDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteConnectionMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteConnectionMessagesResponse); i {
 			case 0:
 				return &v.state
@@ -763,8 +1404,57 @@ func file_proto_connection_messages_proto_init() {
 				return nil
 			}
 		}
+		file_proto_connection_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamConnectionMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamConnectionMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportConnectionMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_connection_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportConnectionMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_proto_connection_messages_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_proto_connection_messages_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_proto_connection_messages_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*DeleteConnectionMessagesRequest_MessageIds)(nil),
 		(*DeleteConnectionMessagesRequest_All)(nil),
 	}
@@ -773,13 +1463,14 @@ func file_proto_connection_messages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_connection_messages_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_connection_messages_proto_goTypes,
 		DependencyIndexes: file_proto_connection_messages_proto_depIdxs,
+		EnumInfos:         file_proto_connection_messages_proto_enumTypes,
 		MessageInfos:      file_proto_connection_messages_proto_msgTypes,
 	}.Build()
 	File_proto_connection_messages_proto = out.File
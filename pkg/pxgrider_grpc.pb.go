@@ -26,14 +26,20 @@ const (
 	PxgriderService_GetConnection_FullMethodName                = "/pxgrider_proto.PxgriderService/GetConnection"
 	PxgriderService_UpdateConnection_FullMethodName             = "/pxgrider_proto.PxgriderService/UpdateConnection"
 	PxgriderService_DeleteConnection_FullMethodName             = "/pxgrider_proto.PxgriderService/DeleteConnection"
+	PxgriderService_GetConnectionSinks_FullMethodName           = "/pxgrider_proto.PxgriderService/GetConnectionSinks"
+	PxgriderService_SetConnectionSinks_FullMethodName           = "/pxgrider_proto.PxgriderService/SetConnectionSinks"
+	PxgriderService_SetConnectionLogLevel_FullMethodName        = "/pxgrider_proto.PxgriderService/SetConnectionLogLevel"
 	PxgriderService_RefreshConnection_FullMethodName            = "/pxgrider_proto.PxgriderService/RefreshConnection"
 	PxgriderService_GetAllSubscriptions_FullMethodName          = "/pxgrider_proto.PxgriderService/GetAllSubscriptions"
 	PxgriderService_GetSubscription_FullMethodName              = "/pxgrider_proto.PxgriderService/GetSubscription"
 	PxgriderService_SubscribeConnection_FullMethodName          = "/pxgrider_proto.PxgriderService/SubscribeConnection"
+	PxgriderService_SetSubscriptionSinks_FullMethodName         = "/pxgrider_proto.PxgriderService/SetSubscriptionSinks"
 	PxgriderService_UnsubscribeConnection_FullMethodName        = "/pxgrider_proto.PxgriderService/UnsubscribeConnection"
 	PxgriderService_GetConnectionMessages_FullMethodName        = "/pxgrider_proto.PxgriderService/GetConnectionMessages"
 	PxgriderService_MarkConnectionMessagesAsRead_FullMethodName = "/pxgrider_proto.PxgriderService/MarkConnectionMessagesAsRead"
 	PxgriderService_DeleteConnectionMessages_FullMethodName     = "/pxgrider_proto.PxgriderService/DeleteConnectionMessages"
+	PxgriderService_StreamConnectionMessages_FullMethodName     = "/pxgrider_proto.PxgriderService/StreamConnectionMessages"
+	PxgriderService_ExportConnectionMessages_FullMethodName     = "/pxgrider_proto.PxgriderService/ExportConnectionMessages"
 	PxgriderService_GetConnectionLogs_FullMethodName            = "/pxgrider_proto.PxgriderService/GetConnectionLogs"
 	PxgriderService_DeleteConnectionLogs_FullMethodName         = "/pxgrider_proto.PxgriderService/DeleteConnectionLogs"
 	PxgriderService_GetConnectionServices_FullMethodName        = "/pxgrider_proto.PxgriderService/GetConnectionServices"
@@ -46,6 +52,10 @@ const (
 	PxgriderService_GetConnectionTopics_FullMethodName          = "/pxgrider_proto.PxgriderService/GetConnectionTopics"
 	PxgriderService_GetServiceTopics_FullMethodName             = "/pxgrider_proto.PxgriderService/GetServiceTopics"
 	PxgriderService_RefreshAccountState_FullMethodName          = "/pxgrider_proto.PxgriderService/RefreshAccountState"
+	PxgriderService_CreateApiToken_FullMethodName               = "/pxgrider_proto.PxgriderService/CreateApiToken"
+	PxgriderService_ListApiTokens_FullMethodName                = "/pxgrider_proto.PxgriderService/ListApiTokens"
+	PxgriderService_RevokeApiToken_FullMethodName               = "/pxgrider_proto.PxgriderService/RevokeApiToken"
+	PxgriderService_GetAuditEvents_FullMethodName               = "/pxgrider_proto.PxgriderService/GetAuditEvents"
 )
 
 // PxgriderServiceClient is the client API for PxgriderService service.
@@ -59,14 +69,20 @@ type PxgriderServiceClient interface {
 	GetConnection(ctx context.Context, in *GetConnectionRequest, opts ...grpc.CallOption) (*GetConnectionResponse, error)
 	UpdateConnection(ctx context.Context, in *UpdateConnectionRequest, opts ...grpc.CallOption) (*UpdateConnectionResponse, error)
 	DeleteConnection(ctx context.Context, in *DeleteConnectionRequest, opts ...grpc.CallOption) (*DeleteConnectionResponse, error)
+	GetConnectionSinks(ctx context.Context, in *GetConnectionSinksRequest, opts ...grpc.CallOption) (*GetConnectionSinksResponse, error)
+	SetConnectionSinks(ctx context.Context, in *SetConnectionSinksRequest, opts ...grpc.CallOption) (*SetConnectionSinksResponse, error)
+	SetConnectionLogLevel(ctx context.Context, in *SetConnectionLogLevelRequest, opts ...grpc.CallOption) (*SetConnectionLogLevelResponse, error)
 	RefreshConnection(ctx context.Context, in *RefreshConnectionRequest, opts ...grpc.CallOption) (*RefreshConnectionResponse, error)
 	GetAllSubscriptions(ctx context.Context, in *GetAllSubscriptionsRequest, opts ...grpc.CallOption) (*GetAllSubscriptionsResponse, error)
 	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*GetSubscriptionResponse, error)
 	SubscribeConnection(ctx context.Context, in *SubscribeConnectionRequest, opts ...grpc.CallOption) (*SubscribeConnectionResponse, error)
+	SetSubscriptionSinks(ctx context.Context, in *SetSubscriptionSinksRequest, opts ...grpc.CallOption) (*SetSubscriptionSinksResponse, error)
 	UnsubscribeConnection(ctx context.Context, in *UnsubscribeConnectionRequest, opts ...grpc.CallOption) (*UnsubscribeConnectionResponse, error)
 	GetConnectionMessages(ctx context.Context, in *GetConnectionMessagesRequest, opts ...grpc.CallOption) (*GetConnectionMessagesResponse, error)
 	MarkConnectionMessagesAsRead(ctx context.Context, in *MarkConnectionMessagesAsReadRequest, opts ...grpc.CallOption) (*MarkConnectionMessagesAsReadResponse, error)
 	DeleteConnectionMessages(ctx context.Context, in *DeleteConnectionMessagesRequest, opts ...grpc.CallOption) (*DeleteConnectionMessagesResponse, error)
+	StreamConnectionMessages(ctx context.Context, in *StreamConnectionMessagesRequest, opts ...grpc.CallOption) (PxgriderService_StreamConnectionMessagesClient, error)
+	ExportConnectionMessages(ctx context.Context, in *ExportConnectionMessagesRequest, opts ...grpc.CallOption) (PxgriderService_ExportConnectionMessagesClient, error)
 	GetConnectionLogs(ctx context.Context, in *GetConnectionLogsRequest, opts ...grpc.CallOption) (*GetConnectionLogsResponse, error)
 	DeleteConnectionLogs(ctx context.Context, in *DeleteConnectionLogsRequest, opts ...grpc.CallOption) (*DeleteConnectionLogsResponse, error)
 	GetConnectionServices(ctx context.Context, in *GetConnectionServicesRequest, opts ...grpc.CallOption) (*GetConnectionServicesResponse, error)
@@ -79,6 +95,10 @@ type PxgriderServiceClient interface {
 	GetConnectionTopics(ctx context.Context, in *GetConnectionTopicsRequest, opts ...grpc.CallOption) (*GetConnectionTopicsResponse, error)
 	GetServiceTopics(ctx context.Context, in *GetServiceTopicsRequest, opts ...grpc.CallOption) (*GetServiceTopicsResponse, error)
 	RefreshAccountState(ctx context.Context, in *RefreshAccountStateRequest, opts ...grpc.CallOption) (*RefreshAccountStateResponse, error)
+	CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error)
+	ListApiTokens(ctx context.Context, in *ListApiTokensRequest, opts ...grpc.CallOption) (*ListApiTokensResponse, error)
+	RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*RevokeApiTokenResponse, error)
+	GetAuditEvents(ctx context.Context, in *GetAuditEventsRequest, opts ...grpc.CallOption) (*GetAuditEventsResponse, error)
 }
 
 type pxgriderServiceClient struct {
@@ -152,6 +172,33 @@ func (c *pxgriderServiceClient) DeleteConnection(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *pxgriderServiceClient) GetConnectionSinks(ctx context.Context, in *GetConnectionSinksRequest, opts ...grpc.CallOption) (*GetConnectionSinksResponse, error) {
+	out := new(GetConnectionSinksResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_GetConnectionSinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pxgriderServiceClient) SetConnectionSinks(ctx context.Context, in *SetConnectionSinksRequest, opts ...grpc.CallOption) (*SetConnectionSinksResponse, error) {
+	out := new(SetConnectionSinksResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_SetConnectionSinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pxgriderServiceClient) SetConnectionLogLevel(ctx context.Context, in *SetConnectionLogLevelRequest, opts ...grpc.CallOption) (*SetConnectionLogLevelResponse, error) {
+	out := new(SetConnectionLogLevelResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_SetConnectionLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pxgriderServiceClient) RefreshConnection(ctx context.Context, in *RefreshConnectionRequest, opts ...grpc.CallOption) (*RefreshConnectionResponse, error) {
 	out := new(RefreshConnectionResponse)
 	err := c.cc.Invoke(ctx, PxgriderService_RefreshConnection_FullMethodName, in, out, opts...)
@@ -188,6 +235,15 @@ func (c *pxgriderServiceClient) SubscribeConnection(ctx context.Context, in *Sub
 	return out, nil
 }
 
+func (c *pxgriderServiceClient) SetSubscriptionSinks(ctx context.Context, in *SetSubscriptionSinksRequest, opts ...grpc.CallOption) (*SetSubscriptionSinksResponse, error) {
+	out := new(SetSubscriptionSinksResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_SetSubscriptionSinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pxgriderServiceClient) UnsubscribeConnection(ctx context.Context, in *UnsubscribeConnectionRequest, opts ...grpc.CallOption) (*UnsubscribeConnectionResponse, error) {
 	out := new(UnsubscribeConnectionResponse)
 	err := c.cc.Invoke(ctx, PxgriderService_UnsubscribeConnection_FullMethodName, in, out, opts...)
@@ -224,6 +280,70 @@ func (c *pxgriderServiceClient) DeleteConnectionMessages(ctx context.Context, in
 	return out, nil
 }
 
+func (c *pxgriderServiceClient) StreamConnectionMessages(ctx context.Context, in *StreamConnectionMessagesRequest, opts ...grpc.CallOption) (PxgriderService_StreamConnectionMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PxgriderService_ServiceDesc.Streams[0], PxgriderService_StreamConnectionMessages_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pxgriderServiceStreamConnectionMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PxgriderService_StreamConnectionMessagesClient interface {
+	Recv() (*StreamConnectionMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type pxgriderServiceStreamConnectionMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *pxgriderServiceStreamConnectionMessagesClient) Recv() (*StreamConnectionMessagesResponse, error) {
+	m := new(StreamConnectionMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pxgriderServiceClient) ExportConnectionMessages(ctx context.Context, in *ExportConnectionMessagesRequest, opts ...grpc.CallOption) (PxgriderService_ExportConnectionMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PxgriderService_ServiceDesc.Streams[1], PxgriderService_ExportConnectionMessages_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pxgriderServiceExportConnectionMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PxgriderService_ExportConnectionMessagesClient interface {
+	Recv() (*ExportConnectionMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type pxgriderServiceExportConnectionMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *pxgriderServiceExportConnectionMessagesClient) Recv() (*ExportConnectionMessagesResponse, error) {
+	m := new(ExportConnectionMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *pxgriderServiceClient) GetConnectionLogs(ctx context.Context, in *GetConnectionLogsRequest, opts ...grpc.CallOption) (*GetConnectionLogsResponse, error) {
 	out := new(GetConnectionLogsResponse)
 	err := c.cc.Invoke(ctx, PxgriderService_GetConnectionLogs_FullMethodName, in, out, opts...)
@@ -332,6 +452,42 @@ func (c *pxgriderServiceClient) RefreshAccountState(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *pxgriderServiceClient) CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error) {
+	out := new(CreateApiTokenResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_CreateApiToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pxgriderServiceClient) ListApiTokens(ctx context.Context, in *ListApiTokensRequest, opts ...grpc.CallOption) (*ListApiTokensResponse, error) {
+	out := new(ListApiTokensResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_ListApiTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pxgriderServiceClient) RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*RevokeApiTokenResponse, error) {
+	out := new(RevokeApiTokenResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_RevokeApiToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pxgriderServiceClient) GetAuditEvents(ctx context.Context, in *GetAuditEventsRequest, opts ...grpc.CallOption) (*GetAuditEventsResponse, error) {
+	out := new(GetAuditEventsResponse)
+	err := c.cc.Invoke(ctx, PxgriderService_GetAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PxgriderServiceServer is the server API for PxgriderService service.
 // All implementations must embed UnimplementedPxgriderServiceServer
 // for forward compatibility
@@ -343,14 +499,20 @@ type PxgriderServiceServer interface {
 	GetConnection(context.Context, *GetConnectionRequest) (*GetConnectionResponse, error)
 	UpdateConnection(context.Context, *UpdateConnectionRequest) (*UpdateConnectionResponse, error)
 	DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error)
+	GetConnectionSinks(context.Context, *GetConnectionSinksRequest) (*GetConnectionSinksResponse, error)
+	SetConnectionSinks(context.Context, *SetConnectionSinksRequest) (*SetConnectionSinksResponse, error)
+	SetConnectionLogLevel(context.Context, *SetConnectionLogLevelRequest) (*SetConnectionLogLevelResponse, error)
 	RefreshConnection(context.Context, *RefreshConnectionRequest) (*RefreshConnectionResponse, error)
 	GetAllSubscriptions(context.Context, *GetAllSubscriptionsRequest) (*GetAllSubscriptionsResponse, error)
 	GetSubscription(context.Context, *GetSubscriptionRequest) (*GetSubscriptionResponse, error)
 	SubscribeConnection(context.Context, *SubscribeConnectionRequest) (*SubscribeConnectionResponse, error)
+	SetSubscriptionSinks(context.Context, *SetSubscriptionSinksRequest) (*SetSubscriptionSinksResponse, error)
 	UnsubscribeConnection(context.Context, *UnsubscribeConnectionRequest) (*UnsubscribeConnectionResponse, error)
 	GetConnectionMessages(context.Context, *GetConnectionMessagesRequest) (*GetConnectionMessagesResponse, error)
 	MarkConnectionMessagesAsRead(context.Context, *MarkConnectionMessagesAsReadRequest) (*MarkConnectionMessagesAsReadResponse, error)
 	DeleteConnectionMessages(context.Context, *DeleteConnectionMessagesRequest) (*DeleteConnectionMessagesResponse, error)
+	StreamConnectionMessages(*StreamConnectionMessagesRequest, PxgriderService_StreamConnectionMessagesServer) error
+	ExportConnectionMessages(*ExportConnectionMessagesRequest, PxgriderService_ExportConnectionMessagesServer) error
 	GetConnectionLogs(context.Context, *GetConnectionLogsRequest) (*GetConnectionLogsResponse, error)
 	DeleteConnectionLogs(context.Context, *DeleteConnectionLogsRequest) (*DeleteConnectionLogsResponse, error)
 	GetConnectionServices(context.Context, *GetConnectionServicesRequest) (*GetConnectionServicesResponse, error)
@@ -363,6 +525,10 @@ type PxgriderServiceServer interface {
 	GetConnectionTopics(context.Context, *GetConnectionTopicsRequest) (*GetConnectionTopicsResponse, error)
 	GetServiceTopics(context.Context, *GetServiceTopicsRequest) (*GetServiceTopicsResponse, error)
 	RefreshAccountState(context.Context, *RefreshAccountStateRequest) (*RefreshAccountStateResponse, error)
+	CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error)
+	ListApiTokens(context.Context, *ListApiTokensRequest) (*ListApiTokensResponse, error)
+	RevokeApiToken(context.Context, *RevokeApiTokenRequest) (*RevokeApiTokenResponse, error)
+	GetAuditEvents(context.Context, *GetAuditEventsRequest) (*GetAuditEventsResponse, error)
 	mustEmbedUnimplementedPxgriderServiceServer()
 }
 
@@ -391,6 +557,15 @@ func (UnimplementedPxgriderServiceServer) UpdateConnection(context.Context, *Upd
 func (UnimplementedPxgriderServiceServer) DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnection not implemented")
 }
+func (UnimplementedPxgriderServiceServer) GetConnectionSinks(context.Context, *GetConnectionSinksRequest) (*GetConnectionSinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionSinks not implemented")
+}
+func (UnimplementedPxgriderServiceServer) SetConnectionSinks(context.Context, *SetConnectionSinksRequest) (*SetConnectionSinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConnectionSinks not implemented")
+}
+func (UnimplementedPxgriderServiceServer) SetConnectionLogLevel(context.Context, *SetConnectionLogLevelRequest) (*SetConnectionLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConnectionLogLevel not implemented")
+}
 func (UnimplementedPxgriderServiceServer) RefreshConnection(context.Context, *RefreshConnectionRequest) (*RefreshConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshConnection not implemented")
 }
@@ -403,6 +578,9 @@ func (UnimplementedPxgriderServiceServer) GetSubscription(context.Context, *GetS
 func (UnimplementedPxgriderServiceServer) SubscribeConnection(context.Context, *SubscribeConnectionRequest) (*SubscribeConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeConnection not implemented")
 }
+func (UnimplementedPxgriderServiceServer) SetSubscriptionSinks(context.Context, *SetSubscriptionSinksRequest) (*SetSubscriptionSinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubscriptionSinks not implemented")
+}
 func (UnimplementedPxgriderServiceServer) UnsubscribeConnection(context.Context, *UnsubscribeConnectionRequest) (*UnsubscribeConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeConnection not implemented")
 }
@@ -415,6 +593,12 @@ func (UnimplementedPxgriderServiceServer) MarkConnectionMessagesAsRead(context.C
 func (UnimplementedPxgriderServiceServer) DeleteConnectionMessages(context.Context, *DeleteConnectionMessagesRequest) (*DeleteConnectionMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnectionMessages not implemented")
 }
+func (UnimplementedPxgriderServiceServer) StreamConnectionMessages(*StreamConnectionMessagesRequest, PxgriderService_StreamConnectionMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamConnectionMessages not implemented")
+}
+func (UnimplementedPxgriderServiceServer) ExportConnectionMessages(*ExportConnectionMessagesRequest, PxgriderService_ExportConnectionMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportConnectionMessages not implemented")
+}
 func (UnimplementedPxgriderServiceServer) GetConnectionLogs(context.Context, *GetConnectionLogsRequest) (*GetConnectionLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionLogs not implemented")
 }
@@ -451,6 +635,18 @@ func (UnimplementedPxgriderServiceServer) GetServiceTopics(context.Context, *Get
 func (UnimplementedPxgriderServiceServer) RefreshAccountState(context.Context, *RefreshAccountStateRequest) (*RefreshAccountStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshAccountState not implemented")
 }
+func (UnimplementedPxgriderServiceServer) CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiToken not implemented")
+}
+func (UnimplementedPxgriderServiceServer) ListApiTokens(context.Context, *ListApiTokensRequest) (*ListApiTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiTokens not implemented")
+}
+func (UnimplementedPxgriderServiceServer) RevokeApiToken(context.Context, *RevokeApiTokenRequest) (*RevokeApiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiToken not implemented")
+}
+func (UnimplementedPxgriderServiceServer) GetAuditEvents(context.Context, *GetAuditEventsRequest) (*GetAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvents not implemented")
+}
 func (UnimplementedPxgriderServiceServer) mustEmbedUnimplementedPxgriderServiceServer() {}
 
 // UnsafePxgriderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -590,6 +786,60 @@ func _PxgriderService_DeleteConnection_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PxgriderService_GetConnectionSinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionSinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).GetConnectionSinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_GetConnectionSinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).GetConnectionSinks(ctx, req.(*GetConnectionSinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PxgriderService_SetConnectionSinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConnectionSinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).SetConnectionSinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_SetConnectionSinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).SetConnectionSinks(ctx, req.(*SetConnectionSinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PxgriderService_SetConnectionLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConnectionLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).SetConnectionLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_SetConnectionLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).SetConnectionLogLevel(ctx, req.(*SetConnectionLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PxgriderService_RefreshConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshConnectionRequest)
 	if err := dec(in); err != nil {
@@ -662,6 +912,24 @@ func _PxgriderService_SubscribeConnection_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PxgriderService_SetSubscriptionSinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubscriptionSinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).SetSubscriptionSinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_SetSubscriptionSinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).SetSubscriptionSinks(ctx, req.(*SetSubscriptionSinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PxgriderService_UnsubscribeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnsubscribeConnectionRequest)
 	if err := dec(in); err != nil {
@@ -734,6 +1002,48 @@ func _PxgriderService_DeleteConnectionMessages_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PxgriderService_StreamConnectionMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamConnectionMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PxgriderServiceServer).StreamConnectionMessages(m, &pxgriderServiceStreamConnectionMessagesServer{stream})
+}
+
+type PxgriderService_StreamConnectionMessagesServer interface {
+	Send(*StreamConnectionMessagesResponse) error
+	grpc.ServerStream
+}
+
+type pxgriderServiceStreamConnectionMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *pxgriderServiceStreamConnectionMessagesServer) Send(m *StreamConnectionMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PxgriderService_ExportConnectionMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportConnectionMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PxgriderServiceServer).ExportConnectionMessages(m, &pxgriderServiceExportConnectionMessagesServer{stream})
+}
+
+type PxgriderService_ExportConnectionMessagesServer interface {
+	Send(*ExportConnectionMessagesResponse) error
+	grpc.ServerStream
+}
+
+type pxgriderServiceExportConnectionMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *pxgriderServiceExportConnectionMessagesServer) Send(m *ExportConnectionMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PxgriderService_GetConnectionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConnectionLogsRequest)
 	if err := dec(in); err != nil {
@@ -950,6 +1260,78 @@ func _PxgriderService_RefreshAccountState_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PxgriderService_CreateApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).CreateApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_CreateApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).CreateApiToken(ctx, req.(*CreateApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PxgriderService_ListApiTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).ListApiTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_ListApiTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).ListApiTokens(ctx, req.(*ListApiTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PxgriderService_RevokeApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).RevokeApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_RevokeApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).RevokeApiToken(ctx, req.(*RevokeApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PxgriderService_GetAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PxgriderServiceServer).GetAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PxgriderService_GetAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PxgriderServiceServer).GetAuditEvents(ctx, req.(*GetAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PxgriderService_ServiceDesc is the grpc.ServiceDesc for PxgriderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -985,6 +1367,18 @@ var PxgriderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteConnection",
 			Handler:    _PxgriderService_DeleteConnection_Handler,
 		},
+		{
+			MethodName: "GetConnectionSinks",
+			Handler:    _PxgriderService_GetConnectionSinks_Handler,
+		},
+		{
+			MethodName: "SetConnectionSinks",
+			Handler:    _PxgriderService_SetConnectionSinks_Handler,
+		},
+		{
+			MethodName: "SetConnectionLogLevel",
+			Handler:    _PxgriderService_SetConnectionLogLevel_Handler,
+		},
 		{
 			MethodName: "RefreshConnection",
 			Handler:    _PxgriderService_RefreshConnection_Handler,
@@ -1001,6 +1395,10 @@ var PxgriderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubscribeConnection",
 			Handler:    _PxgriderService_SubscribeConnection_Handler,
 		},
+		{
+			MethodName: "SetSubscriptionSinks",
+			Handler:    _PxgriderService_SetSubscriptionSinks_Handler,
+		},
 		{
 			MethodName: "UnsubscribeConnection",
 			Handler:    _PxgriderService_UnsubscribeConnection_Handler,
@@ -1065,7 +1463,34 @@ var PxgriderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshAccountState",
 			Handler:    _PxgriderService_RefreshAccountState_Handler,
 		},
+		{
+			MethodName: "CreateApiToken",
+			Handler:    _PxgriderService_CreateApiToken_Handler,
+		},
+		{
+			MethodName: "ListApiTokens",
+			Handler:    _PxgriderService_ListApiTokens_Handler,
+		},
+		{
+			MethodName: "RevokeApiToken",
+			Handler:    _PxgriderService_RevokeApiToken_Handler,
+		},
+		{
+			MethodName: "GetAuditEvents",
+			Handler:    _PxgriderService_GetAuditEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamConnectionMessages",
+			Handler:       _PxgriderService_StreamConnectionMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportConnectionMessages",
+			Handler:       _PxgriderService_ExportConnectionMessages_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/pxgrider.proto",
 }
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.26.1
+// source: proto/retention.proto
+
+package pxgrider_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Retention overrides the server wide retention of a connection, unset fields
+// fall back to it and zero values disable the limit
+type Retention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessagesMaxAge  *durationpb.Duration `protobuf:"bytes,1,opt,name=messages_max_age,json=messagesMaxAge,proto3" json:"messages_max_age,omitempty"`
+	MessagesMaxRows *int64               `protobuf:"varint,2,opt,name=messages_max_rows,json=messagesMaxRows,proto3,oneof" json:"messages_max_rows,omitempty"`
+	LogsMaxAge      *durationpb.Duration `protobuf:"bytes,3,opt,name=logs_max_age,json=logsMaxAge,proto3" json:"logs_max_age,omitempty"`
+	LogsMaxRows     *int64               `protobuf:"varint,4,opt,name=logs_max_rows,json=logsMaxRows,proto3,oneof" json:"logs_max_rows,omitempty"`
+}
+
+func (x *Retention) Reset() {
+	*x = Retention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_retention_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retention) ProtoMessage() {}
+
+func (x *Retention) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_retention_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retention.ProtoReflect.Descriptor instead.
+func (*Retention) Descriptor() ([]byte, []int) {
+	return file_proto_retention_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Retention) GetMessagesMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MessagesMaxAge
+	}
+	return nil
+}
+
+func (x *Retention) GetMessagesMaxRows() int64 {
+	if x != nil && x.MessagesMaxRows != nil {
+		return *x.MessagesMaxRows
+	}
+	return 0
+}
+
+func (x *Retention) GetLogsMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.LogsMaxAge
+	}
+	return nil
+}
+
+func (x *Retention) GetLogsMaxRows() int64 {
+	if x != nil && x.LogsMaxRows != nil {
+		return *x.LogsMaxRows
+	}
+	return 0
+}
+
+var File_proto_retention_proto protoreflect.FileDescriptor
+
+var file_proto_retention_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x4d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x67, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6c, 0x6f,
+	0x67, 0x73, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x73,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x01, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x73, 0x4d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6b, 0x75, 0x6d, 0x6f, 0x76, 0x2f, 0x67,
+	0x6f, 0x2d, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x78, 0x67, 0x72, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_retention_proto_rawDescOnce sync.Once
+	file_proto_retention_proto_rawDescData = file_proto_retention_proto_rawDesc
+)
+
+func file_proto_retention_proto_rawDescGZIP() []byte {
+	file_proto_retention_proto_rawDescOnce.Do(func() {
+		file_proto_retention_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_retention_proto_rawDescData)
+	})
+	return file_proto_retention_proto_rawDescData
+}
+
+var file_proto_retention_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_retention_proto_goTypes = []interface{}{
+	(*Retention)(nil),           // 0: pxgrider_proto.Retention
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+}
+var file_proto_retention_proto_depIdxs = []int32{
+	1, // 0: pxgrider_proto.Retention.messages_max_age:type_name -> google.protobuf.Duration
+	1, // 1: pxgrider_proto.Retention.logs_max_age:type_name -> google.protobuf.Duration
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_retention_proto_init() }
+func file_proto_retention_proto_init() {
+	if File_proto_retention_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_retention_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_retention_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_retention_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_retention_proto_goTypes,
+		DependencyIndexes: file_proto_retention_proto_depIdxs,
+		MessageInfos:      file_proto_retention_proto_msgTypes,
+	}.Build()
+	File_proto_retention_proto = out.File
+	file_proto_retention_proto_rawDesc = nil
+	file_proto_retention_proto_goTypes = nil
+	file_proto_retention_proto_depIdxs = nil
+}
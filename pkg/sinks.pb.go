@@ -0,0 +1,1129 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.26.1
+// source: proto/sinks.proto
+
+package pxgrider_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SyslogNetwork int32
+
+const (
+	SyslogNetwork_SYSLOG_NETWORK_UDP SyslogNetwork = 0
+	SyslogNetwork_SYSLOG_NETWORK_TCP SyslogNetwork = 1
+	SyslogNetwork_SYSLOG_NETWORK_TLS SyslogNetwork = 2
+)
+
+// Enum value maps for SyslogNetwork.
+var (
+	SyslogNetwork_name = map[int32]string{
+		0: "SYSLOG_NETWORK_UDP",
+		1: "SYSLOG_NETWORK_TCP",
+		2: "SYSLOG_NETWORK_TLS",
+	}
+	SyslogNetwork_value = map[string]int32{
+		"SYSLOG_NETWORK_UDP": 0,
+		"SYSLOG_NETWORK_TCP": 1,
+		"SYSLOG_NETWORK_TLS": 2,
+	}
+)
+
+func (x SyslogNetwork) Enum() *SyslogNetwork {
+	p := new(SyslogNetwork)
+	*p = x
+	return p
+}
+
+func (x SyslogNetwork) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyslogNetwork) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_sinks_proto_enumTypes[0].Descriptor()
+}
+
+func (SyslogNetwork) Type() protoreflect.EnumType {
+	return &file_proto_sinks_proto_enumTypes[0]
+}
+
+func (x SyslogNetwork) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyslogNetwork.Descriptor instead.
+func (SyslogNetwork) EnumDescriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{0}
+}
+
+type SyslogFormat int32
+
+const (
+	SyslogFormat_SYSLOG_FORMAT_RFC5424 SyslogFormat = 0
+	SyslogFormat_SYSLOG_FORMAT_CEF     SyslogFormat = 1
+)
+
+// Enum value maps for SyslogFormat.
+var (
+	SyslogFormat_name = map[int32]string{
+		0: "SYSLOG_FORMAT_RFC5424",
+		1: "SYSLOG_FORMAT_CEF",
+	}
+	SyslogFormat_value = map[string]int32{
+		"SYSLOG_FORMAT_RFC5424": 0,
+		"SYSLOG_FORMAT_CEF":     1,
+	}
+)
+
+func (x SyslogFormat) Enum() *SyslogFormat {
+	p := new(SyslogFormat)
+	*p = x
+	return p
+}
+
+func (x SyslogFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyslogFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_sinks_proto_enumTypes[1].Descriptor()
+}
+
+func (SyslogFormat) Type() protoreflect.EnumType {
+	return &file_proto_sinks_proto_enumTypes[1]
+}
+
+func (x SyslogFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyslogFormat.Descriptor instead.
+func (SyslogFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{1}
+}
+
+// Secrets are never returned, has_* report whether they are set. Secrets and
+// header values left empty in an update keep the stored ones of the sink with
+// the same name.
+type WebhookSink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// values are returned empty
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// HMAC-SHA256 key, requests are signed in X-Pxgrider-Signature
+	Secret      string               `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Timeout     *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	InsecureTls bool                 `protobuf:"varint,5,opt,name=insecure_tls,json=insecureTls,proto3" json:"insecure_tls,omitempty"`
+	HasSecret   bool                 `protobuf:"varint,6,opt,name=has_secret,json=hasSecret,proto3" json:"has_secret,omitempty"`
+}
+
+func (x *WebhookSink) Reset() {
+	*x = WebhookSink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sinks_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSink) ProtoMessage() {}
+
+func (x *WebhookSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sinks_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSink.ProtoReflect.Descriptor instead.
+func (*WebhookSink) Descriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebhookSink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSink) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *WebhookSink) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSink) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *WebhookSink) GetInsecureTls() bool {
+	if x != nil {
+		return x.InsecureTls
+	}
+	return false
+}
+
+func (x *WebhookSink) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+// Output field (CEF extension key or structured data parameter) to dot
+// separated path in the message
+type SyslogFieldMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields map[string]string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SyslogFieldMap) Reset() {
+	*x = SyslogFieldMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sinks_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyslogFieldMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyslogFieldMap) ProtoMessage() {}
+
+func (x *SyslogFieldMap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sinks_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyslogFieldMap.ProtoReflect.Descriptor instead.
+func (*SyslogFieldMap) Descriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyslogFieldMap) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SyslogSink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network SyslogNetwork `protobuf:"varint,1,opt,name=network,proto3,enum=pxgrider_proto.SyslogNetwork" json:"network,omitempty"`
+	// host:port
+	Address string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Format  SyslogFormat `protobuf:"varint,3,opt,name=format,proto3,enum=pxgrider_proto.SyslogFormat" json:"format,omitempty"`
+	// syslog facility and severity names, local0 and info by default
+	Facility    string `protobuf:"bytes,4,opt,name=facility,proto3" json:"facility,omitempty"`
+	Severity    string `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity,omitempty"`
+	AppName     string `protobuf:"bytes,6,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Hostname    string `protobuf:"bytes,7,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	InsecureTls bool   `protobuf:"varint,8,opt,name=insecure_tls,json=insecureTls,proto3" json:"insecure_tls,omitempty"`
+	// keyed by topic, built-in mappings are used for topics not listed
+	Mappings map[string]*SyslogFieldMap `protobuf:"bytes,9,rep,name=mappings,proto3" json:"mappings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SyslogSink) Reset() {
+	*x = SyslogSink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sinks_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyslogSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyslogSink) ProtoMessage() {}
+
+func (x *SyslogSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sinks_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyslogSink.ProtoReflect.Descriptor instead.
+func (*SyslogSink) Descriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SyslogSink) GetNetwork() SyslogNetwork {
+	if x != nil {
+		return x.Network
+	}
+	return SyslogNetwork_SYSLOG_NETWORK_UDP
+}
+
+func (x *SyslogSink) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SyslogSink) GetFormat() SyslogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SyslogFormat_SYSLOG_FORMAT_RFC5424
+}
+
+func (x *SyslogSink) GetFacility() string {
+	if x != nil {
+		return x.Facility
+	}
+	return ""
+}
+
+func (x *SyslogSink) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *SyslogSink) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *SyslogSink) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *SyslogSink) GetInsecureTls() bool {
+	if x != nil {
+		return x.InsecureTls
+	}
+	return false
+}
+
+func (x *SyslogSink) GetMappings() map[string]*SyslogFieldMap {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// Records are keyed by "<connection id>/<topic>" or by the values at key_path
+type KafkaSink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// host:port of the bootstrap brokers
+	Brokers  []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	Topic    string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	ClientId string   `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// dot separated path in the message, e.g. sessions.callingStationId
+	KeyPath     string `protobuf:"bytes,4,opt,name=key_path,json=keyPath,proto3" json:"key_path,omitempty"`
+	Tls         bool   `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	InsecureTls bool   `protobuf:"varint,6,opt,name=insecure_tls,json=insecureTls,proto3" json:"insecure_tls,omitempty"`
+	// SASL/PLAIN credentials
+	Username    string               `protobuf:"bytes,7,opt,name=username,proto3" json:"username,omitempty"`
+	Password    string               `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	Timeout     *durationpb.Duration `protobuf:"bytes,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	HasPassword bool                 `protobuf:"varint,10,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`
+}
+
+func (x *KafkaSink) Reset() {
+	*x = KafkaSink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sinks_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaSink) ProtoMessage() {}
+
+func (x *KafkaSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sinks_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaSink.ProtoReflect.Descriptor instead.
+func (*KafkaSink) Descriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KafkaSink) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *KafkaSink) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *KafkaSink) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *KafkaSink) GetKeyPath() string {
+	if x != nil {
+		return x.KeyPath
+	}
+	return ""
+}
+
+func (x *KafkaSink) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *KafkaSink) GetInsecureTls() bool {
+	if x != nil {
+		return x.InsecureTls
+	}
+	return false
+}
+
+func (x *KafkaSink) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *KafkaSink) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *KafkaSink) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *KafkaSink) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+// Messages are published to <subject>.<connection id>.<topic>[.<key>]
+type NatsSink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string               `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Subject     string               `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	KeyPath     string               `protobuf:"bytes,3,opt,name=key_path,json=keyPath,proto3" json:"key_path,omitempty"`
+	Jetstream   bool                 `protobuf:"varint,4,opt,name=jetstream,proto3" json:"jetstream,omitempty"`
+	Username    string               `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Password    string               `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	Token       string               `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	InsecureTls bool                 `protobuf:"varint,8,opt,name=insecure_tls,json=insecureTls,proto3" json:"insecure_tls,omitempty"`
+	Timeout     *durationpb.Duration `protobuf:"bytes,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	HasPassword bool                 `protobuf:"varint,10,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`
+	HasToken    bool                 `protobuf:"varint,11,opt,name=has_token,json=hasToken,proto3" json:"has_token,omitempty"`
+}
+
+func (x *NatsSink) Reset() {
+	*x = NatsSink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sinks_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatsSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatsSink) ProtoMessage() {}
+
+func (x *NatsSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sinks_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatsSink.ProtoReflect.Descriptor instead.
+func (*NatsSink) Descriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NatsSink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NatsSink) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *NatsSink) GetKeyPath() string {
+	if x != nil {
+		return x.KeyPath
+	}
+	return ""
+}
+
+func (x *NatsSink) GetJetstream() bool {
+	if x != nil {
+		return x.Jetstream
+	}
+	return false
+}
+
+func (x *NatsSink) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *NatsSink) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *NatsSink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *NatsSink) GetInsecureTls() bool {
+	if x != nil {
+		return x.InsecureTls
+	}
+	return false
+}
+
+func (x *NatsSink) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *NatsSink) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *NatsSink) GetHasToken() bool {
+	if x != nil {
+		return x.HasToken
+	}
+	return false
+}
+
+type SinkRetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAttempts int32                `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Backoff     *durationpb.Duration `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+}
+
+func (x *SinkRetry) Reset() {
+	*x = SinkRetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sinks_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SinkRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinkRetry) ProtoMessage() {}
+
+func (x *SinkRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sinks_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SinkRetry.ProtoReflect.Descriptor instead.
+func (*SinkRetry) Descriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SinkRetry) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *SinkRetry) GetBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+type Sink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Kind:
+	//	*Sink_Webhook
+	//	*Sink_Syslog
+	//	*Sink_Nats
+	//	*Sink_Kafka
+	Kind isSink_Kind `protobuf_oneof:"kind"`
+	// hold back the subscription instead of queueing on disk when the sink is
+	// failing or behind
+	Block bool       `protobuf:"varint,14,opt,name=block,proto3" json:"block,omitempty"`
+	Retry *SinkRetry `protobuf:"bytes,15,opt,name=retry,proto3" json:"retry,omitempty"`
+}
+
+func (x *Sink) Reset() {
+	*x = Sink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sinks_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sink) ProtoMessage() {}
+
+func (x *Sink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sinks_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sink.ProtoReflect.Descriptor instead.
+func (*Sink) Descriptor() ([]byte, []int) {
+	return file_proto_sinks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Sink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *Sink) GetKind() isSink_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Sink) GetWebhook() *WebhookSink {
+	if x, ok := x.GetKind().(*Sink_Webhook); ok {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *Sink) GetSyslog() *SyslogSink {
+	if x, ok := x.GetKind().(*Sink_Syslog); ok {
+		return x.Syslog
+	}
+	return nil
+}
+
+func (x *Sink) GetNats() *NatsSink {
+	if x, ok := x.GetKind().(*Sink_Nats); ok {
+		return x.Nats
+	}
+	return nil
+}
+
+func (x *Sink) GetKafka() *KafkaSink {
+	if x, ok := x.GetKind().(*Sink_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (x *Sink) GetBlock() bool {
+	if x != nil {
+		return x.Block
+	}
+	return false
+}
+
+func (x *Sink) GetRetry() *SinkRetry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+type isSink_Kind interface {
+	isSink_Kind()
+}
+
+type Sink_Webhook struct {
+	Webhook *WebhookSink `protobuf:"bytes,2,opt,name=webhook,proto3,oneof"`
+}
+
+type Sink_Syslog struct {
+	Syslog *SyslogSink `protobuf:"bytes,3,opt,name=syslog,proto3,oneof"`
+}
+
+type Sink_Nats struct {
+	Nats *NatsSink `protobuf:"bytes,4,opt,name=nats,proto3,oneof"`
+}
+
+type Sink_Kafka struct {
+	Kafka *KafkaSink `protobuf:"bytes,5,opt,name=kafka,proto3,oneof"`
+}
+
+func (*Sink_Webhook) isSink_Kind() {}
+
+func (*Sink_Syslog) isSink_Kind() {}
+
+func (*Sink_Nats) isSink_Kind() {}
+
+func (*Sink_Kafka) isSink_Kind() {}
+
+var File_proto_sinks_proto protoreflect.FileDescriptor
+
+var file_proto_sinks_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xae, 0x02, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53,
+	0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x42, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53,
+	0x69, 0x6e, 0x6b, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68,
+	0x61, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xca, 0x03, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x6c, 0x6f,
+	0x67, 0x53, 0x69, 0x6e, 0x6b, 0x12, 0x37, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x6c, 0x73,
+	0x12, 0x44, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x53, 0x69, 0x6e, 0x6b, 0x2e, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x5b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb8, 0x02, 0x0a, 0x09, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x69, 0x6e,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x68,
+	0x61, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xd5,
+	0x02, 0x0a, 0x08, 0x4e, 0x61, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x6c,
+	0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61,
+	0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x73,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x63, 0x0a, 0x09, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x22, 0xbb, 0x02, 0x0a, 0x04,
+	0x53, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x78, 0x67, 0x72,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x53, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x06, 0x73, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x61, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x61, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x69, 0x6e,
+	0x6b, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x2f, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x2a, 0x57, 0x0a, 0x0d, 0x53, 0x79, 0x73,
+	0x6c, 0x6f, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x59,
+	0x53, 0x4c, 0x4f, 0x47, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x55, 0x44, 0x50,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x5f, 0x4e, 0x45, 0x54,
+	0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x59,
+	0x53, 0x4c, 0x4f, 0x47, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x54, 0x4c, 0x53,
+	0x10, 0x02, 0x2a, 0x40, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x52, 0x46, 0x43, 0x35, 0x34, 0x32, 0x34, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43,
+	0x45, 0x46, 0x10, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x6b, 0x75, 0x6d, 0x6f, 0x76, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x78, 0x67,
+	0x72, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x78, 0x67, 0x72, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_sinks_proto_rawDescOnce sync.Once
+	file_proto_sinks_proto_rawDescData = file_proto_sinks_proto_rawDesc
+)
+
+func file_proto_sinks_proto_rawDescGZIP() []byte {
+	file_proto_sinks_proto_rawDescOnce.Do(func() {
+		file_proto_sinks_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_sinks_proto_rawDescData)
+	})
+	return file_proto_sinks_proto_rawDescData
+}
+
+var file_proto_sinks_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_sinks_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_sinks_proto_goTypes = []interface{}{
+	(SyslogNetwork)(0),          // 0: pxgrider_proto.SyslogNetwork
+	(SyslogFormat)(0),           // 1: pxgrider_proto.SyslogFormat
+	(*WebhookSink)(nil),         // 2: pxgrider_proto.WebhookSink
+	(*SyslogFieldMap)(nil),      // 3: pxgrider_proto.SyslogFieldMap
+	(*SyslogSink)(nil),          // 4: pxgrider_proto.SyslogSink
+	(*KafkaSink)(nil),           // 5: pxgrider_proto.KafkaSink
+	(*NatsSink)(nil),            // 6: pxgrider_proto.NatsSink
+	(*SinkRetry)(nil),           // 7: pxgrider_proto.SinkRetry
+	(*Sink)(nil),                // 8: pxgrider_proto.Sink
+	nil,                         // 9: pxgrider_proto.WebhookSink.HeadersEntry
+	nil,                         // 10: pxgrider_proto.SyslogFieldMap.FieldsEntry
+	nil,                         // 11: pxgrider_proto.SyslogSink.MappingsEntry
+	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+}
+var file_proto_sinks_proto_depIdxs = []int32{
+	9,  // 0: pxgrider_proto.WebhookSink.headers:type_name -> pxgrider_proto.WebhookSink.HeadersEntry
+	12, // 1: pxgrider_proto.WebhookSink.timeout:type_name -> google.protobuf.Duration
+	10, // 2: pxgrider_proto.SyslogFieldMap.fields:type_name -> pxgrider_proto.SyslogFieldMap.FieldsEntry
+	0,  // 3: pxgrider_proto.SyslogSink.network:type_name -> pxgrider_proto.SyslogNetwork
+	1,  // 4: pxgrider_proto.SyslogSink.format:type_name -> pxgrider_proto.SyslogFormat
+	11, // 5: pxgrider_proto.SyslogSink.mappings:type_name -> pxgrider_proto.SyslogSink.MappingsEntry
+	12, // 6: pxgrider_proto.KafkaSink.timeout:type_name -> google.protobuf.Duration
+	12, // 7: pxgrider_proto.NatsSink.timeout:type_name -> google.protobuf.Duration
+	12, // 8: pxgrider_proto.SinkRetry.backoff:type_name -> google.protobuf.Duration
+	2,  // 9: pxgrider_proto.Sink.webhook:type_name -> pxgrider_proto.WebhookSink
+	4,  // 10: pxgrider_proto.Sink.syslog:type_name -> pxgrider_proto.SyslogSink
+	6,  // 11: pxgrider_proto.Sink.nats:type_name -> pxgrider_proto.NatsSink
+	5,  // 12: pxgrider_proto.Sink.kafka:type_name -> pxgrider_proto.KafkaSink
+	7,  // 13: pxgrider_proto.Sink.retry:type_name -> pxgrider_proto.SinkRetry
+	3,  // 14: pxgrider_proto.SyslogSink.MappingsEntry.value:type_name -> pxgrider_proto.SyslogFieldMap
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_proto_sinks_proto_init() }
+func file_proto_sinks_proto_init() {
+	if File_proto_sinks_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_sinks_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookSink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sinks_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyslogFieldMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sinks_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyslogSink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sinks_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KafkaSink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sinks_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NatsSink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sinks_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SinkRetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sinks_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_sinks_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*Sink_Webhook)(nil),
+		(*Sink_Syslog)(nil),
+		(*Sink_Nats)(nil),
+		(*Sink_Kafka)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_sinks_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_sinks_proto_goTypes,
+		DependencyIndexes: file_proto_sinks_proto_depIdxs,
+		EnumInfos:         file_proto_sinks_proto_enumTypes,
+		MessageInfos:      file_proto_sinks_proto_msgTypes,
+	}.Build()
+	File_proto_sinks_proto = out.File
+	file_proto_sinks_proto_rawDesc = nil
+	file_proto_sinks_proto_goTypes = nil
+	file_proto_sinks_proto_depIdxs = nil
+}
@@ -2,6 +2,10 @@ package main
 
 import (
 	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
 
 	gopxgrid "github.com/vkumov/go-pxgrid"
 	"github.com/vkumov/go-pxgrider/server/internal"
@@ -68,11 +72,38 @@ func someTest(app shared.App) {
 func main() {
 	app := internal.NewApp()
 
+	if args := flag.Args(); len(args) > 0 {
+		if err := app.RunCommand(context.Background(), args); err != nil {
+			app.Log().Fatal().Err(err).Strs("args", args).Msg("Command failed")
+		}
+		return
+	}
+
 	if !app.IsProd() {
 		go someTest(app)
 	}
 
-	if err := app.Start(); err != nil {
-		app.Log().Fatal().Err(err).Msg("Failed to start server")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- app.Start()
+	}()
+
+	select {
+	case err := <-errc:
+		if err != nil {
+			app.Log().Fatal().Err(err).Msg("Failed to start server")
+		}
+	case <-ctx.Done():
+		stop()
+		app.Log().Info().Msg("Shutting down")
+		if err := app.Shutdown(context.Background()); err != nil {
+			app.Log().Error().Err(err).Msg("Failed to shut down cleanly")
+		}
+		if err := <-errc; err != nil {
+			app.Log().Error().Err(err).Msg("Server stopped with error")
+		}
 	}
 }
@@ -1,11 +1,18 @@
 package internal
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"path/filepath"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -13,35 +20,134 @@ import (
 
 	pb "github.com/vkumov/go-pxgrider/pkg"
 
+	"github.com/vkumov/go-pxgrider/server/internal/audit"
 	"github.com/vkumov/go-pxgrider/server/internal/auth"
+	"github.com/vkumov/go-pxgrider/server/internal/certs"
 	"github.com/vkumov/go-pxgrider/server/internal/config"
+	"github.com/vkumov/go-pxgrider/server/internal/db"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
+	"github.com/vkumov/go-pxgrider/server/internal/ingest"
+	"github.com/vkumov/go-pxgrider/server/internal/logger"
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
+	"github.com/vkumov/go-pxgrider/server/internal/secrets"
 	"github.com/vkumov/go-pxgrider/server/internal/server"
+	"github.com/vkumov/go-pxgrider/server/internal/tracing"
 	"github.com/vkumov/go-pxgrider/server/shared"
 )
 
 type App struct {
 	cfg        *config.AppConfig
 	users      shared.UsersHandler
+	tokens     *auth.Store
 	grpcServer *grpc.Server
+	certs      *certs.Reloader
+	audit      *audit.Recorder
+	ingest     *ingest.Pipeline
+	metrics    *http.Server
+	tracing    func(context.Context) error
 	pxServer   pb.PxgriderServiceServer
 	health     *health.Server
 
-	ready chan struct{}
+	ready    chan struct{}
+	stopping chan struct{}
 }
 
 func NewApp() *App {
 	app := &App{
-		cfg:   config.NewConfig(),
-		ready: make(chan struct{}),
+		cfg:      config.NewConfig(),
+		ready:    make(chan struct{}),
+		stopping: make(chan struct{}),
+	}
+	ing := app.cfg.Specs.Ingest
+	ingestLogger := app.cfg.Logger().With().Str("component", "ingest").Logger()
+	app.ingest = ingest.New(app.cfg.DB(), app.cfg.Dialect(), ingest.Options{
+		BatchSize:     ing.BatchSize,
+		FlushInterval: ing.FlushInterval,
+		QueueSize:     ing.QueueSize,
+		RetryInterval: ing.RetryInterval,
+		MaxParams:     app.cfg.Specs.DB.MaxParamsPerStatement,
+	}, &ingestLogger)
+
+	app.users = NewUsers(app.cfg, app.cfg, app.ingest)
+
+	sec := app.cfg.Specs.Secrets
+	keyring, err := secrets.LoadKeyring(sec.Keys, sec.KeyFile, sec.PrimaryKey)
+	if err != nil {
+		panic(fmt.Errorf("failed to load secrets keys: %w", err))
+	}
+	switch {
+	case keyring == nil && sec.RequireEncryption:
+		panic(errors.New("secrets encryption is required but no secrets keys are configured"))
+	case keyring == nil:
+		app.cfg.Logger().Warn().Msg("Secrets keys are not configured, credentials and sink secrets are stored unencrypted")
+	}
+	secrets.Configure(keyring)
+	secrets.RequireEncryption(sec.RequireEncryption)
+
+	fwd := app.cfg.Specs.Forwarder
+	if fwd.QueueDir == "" && app.cfg.Specs.DataDir != "" {
+		fwd.QueueDir = filepath.Join(app.cfg.Specs.DataDir, "queue")
+	}
+	if fwd.QueueDir == "" {
+		app.cfg.Logger().Warn().Msg("Neither the data dir nor the forwarder queue dir is set, sinks can't be started")
+	}
+	forwarder.Configure(forwarder.Options{
+		QueueDir:      fwd.QueueDir,
+		QueueMaxItems: fwd.QueueMaxItems,
+		RetryInterval: fwd.RetryInterval,
+	})
+
+	lg := app.cfg.Specs.Log
+	if err := logger.Configure(logger.Options{
+		QueueSize:  lg.DBQueueSize,
+		Overflow:   lg.DBOverflow,
+		SampleRate: lg.DBSampleRate,
+	}); err != nil {
+		panic(fmt.Errorf("failed to configure connection logs: %w", err))
+	}
+
+	tr := app.cfg.Specs.Tracing
+	app.tracing, err = tracing.Setup(context.Background(), tracing.Options{
+		Exporter:    tr.Exporter,
+		Endpoint:    tr.OTLPEndpoint,
+		Insecure:    tr.OTLPInsecure,
+		SampleRatio: tr.SampleRatio,
+		ServiceName: tr.ServiceName,
+		Version:     app.cfg.Specs.Version.V,
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to setup tracing: %w", err))
 	}
-	app.users = NewUsers(app.cfg, app.cfg)
 
 	authLogger := app.cfg.Logger().With().Str("component", "auth").Logger()
-	aut := auth.NewTokenAuthenticator(app.cfg.Token(), &authLogger)
+	app.tokens = auth.NewStore(app.cfg.DB())
+	aut := auth.NewTokenAuthenticator(app.cfg.Token(), app.tokens, &authLogger)
+	if jwtSpecs := app.cfg.Specs.Auth.JWT; jwtSpecs.JWKS != "" {
+		jwtAuth, err := newJWTAuthenticator(jwtSpecs, &authLogger)
+		if err != nil {
+			panic(err)
+		}
+		aut = auth.Combine(&authLogger, aut, jwtAuth)
+	}
+
+	opts := []grpc.ServerOption{grpc.StatsHandler(otelgrpc.NewServerHandler())}
+	if tlsSpecs := app.cfg.Specs.Server.TLS; tlsSpecs.CertFile != "" {
+		certAuth, err := app.setupTLS(tlsSpecs, &authLogger)
+		if err != nil {
+			panic(err)
+		}
+		if certAuth != nil {
+			aut = auth.Combine(&authLogger, aut, certAuth)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(app.certs.TLSConfig())))
+	}
 
-	app.grpcServer = grpc.NewServer(
-		grpc.StreamInterceptor(aut.StreamInterceptor),
-		grpc.UnaryInterceptor(aut.UnaryInterceptor),
+	auditLogger := app.cfg.Logger().With().Str("component", "audit").Logger()
+	app.audit = audit.NewRecorder(app.cfg.DB(), &auditLogger)
+
+	app.grpcServer = grpc.NewServer(append(opts,
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor, aut.StreamInterceptor),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor, aut.UnaryInterceptor, app.audit.UnaryInterceptor),
 		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
 			MinTime:             app.cfg.Specs.Server.EnforcementPolicy.MinTime,
 			PermitWithoutStream: app.cfg.Specs.Server.EnforcementPolicy.PermitWithoutStream,
@@ -53,7 +159,7 @@ func NewApp() *App {
 			Time:                  app.cfg.Specs.Server.Keepalive.Time,
 			Timeout:               app.cfg.Specs.Server.Keepalive.Timeout,
 		}),
-	)
+	)...)
 	app.pxServer = server.NewServer(app)
 	pb.RegisterPxgriderServiceServer(app.grpcServer, app.pxServer)
 
@@ -77,14 +183,53 @@ func (a *App) Start() error {
 		Str("version", a.cfg.Specs.Version.V).
 		Str("env", a.cfg.Specs.Env).
 		Str("address", listen).
+		Bool("tls", a.certs != nil).
+		Str("tracing", a.cfg.Specs.Tracing.Exporter).
 		Msg("Starting server")
 
+	if a.cfg.Specs.DB.AutoMigrate {
+		applied, err := db.MigrateUp(context.Background(), a.cfg.DB(), a.cfg.Dialect())
+		if err != nil {
+			return fmt.Errorf("failed to migrate database: %w", err)
+		}
+		a.cfg.Logger().Info().Int("applied", len(applied)).Msg("Database migrated")
+	} else {
+		a.warnPendingMigrations(context.Background())
+	}
+
+	if err := a.users.LoadAll(context.Background()); err != nil {
+		a.cfg.Logger().Error().Err(err).Msg("Failed to load users")
+	}
+
 	close(a.ready)
 
+	go a.runJanitor()
+	a.startMetrics()
+
 	a.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
 	return a.grpcServer.Serve(lis)
 }
 
+// warnPendingMigrations reports migrations left for the migrate command
+func (a *App) warnPendingMigrations(ctx context.Context) {
+	status, err := db.MigrateStatus(ctx, a.cfg.DB(), a.cfg.Dialect())
+	if err != nil {
+		a.cfg.Logger().Warn().Err(err).Msg("Failed to read the migration status")
+		return
+	}
+
+	pending := 0
+	for _, s := range status {
+		if s.AppliedAt.IsZero() {
+			pending++
+		}
+	}
+	if pending > 0 {
+		a.cfg.Logger().Warn().Int("pending", pending).
+			Msg("Database migrations are pending, apply them with the migrate command or enable auto migration")
+	}
+}
+
 func (a *App) Log() *zerolog.Logger {
 	return a.cfg.Logger()
 }
@@ -93,6 +238,14 @@ func (a *App) Users() shared.UsersHandler {
 	return a.users
 }
 
+func (a *App) Tokens() *auth.Store {
+	return a.tokens
+}
+
+func (a *App) Audit() *audit.Recorder {
+	return a.audit
+}
+
 func (a *App) Ready() <-chan struct{} {
 	return a.ready
 }
@@ -100,3 +253,49 @@ func (a *App) Ready() <-chan struct{} {
 func (a *App) IsProd() bool {
 	return a.cfg.IsProd()
 }
+
+func newJWTAuthenticator(specs config.JWTSpecs, log *zerolog.Logger) (auth.Authenticator, error) {
+	cfg := auth.JWTConfig{
+		JWKS:            specs.JWKS,
+		Issuer:          specs.Issuer,
+		Audience:        specs.Audience,
+		UIDClaim:        specs.UIDClaim,
+		RoleClaim:       specs.RoleClaim,
+		RefreshInterval: specs.RefreshInterval,
+		Leeway:          specs.Leeway,
+	}
+	if specs.DefaultRole != "" && specs.DefaultRole != "none" {
+		role, err := auth.ParseRole(specs.DefaultRole)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_JWT_DEFAULT_ROLE: %w", err)
+		}
+		cfg.DefaultRole = role
+	}
+
+	return auth.NewJWTAuthenticator(cfg, log)
+}
+
+// setupTLS starts the certificate reloader, it returns the client certificate
+// authenticator if client certificates are verified
+func (a *App) setupTLS(specs config.TLSSpecs, log *zerolog.Logger) (auth.Authenticator, error) {
+	clientAuth, err := certs.ParseClientAuth(specs.ClientAuth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS_CLIENT_AUTH: %w", err)
+	}
+
+	certsLogger := a.cfg.Logger().With().Str("component", "certs").Logger()
+	a.certs, err = certs.NewReloader(specs.CertFile, specs.KeyFile, specs.ClientCAFile, clientAuth, &certsLogger)
+	if err != nil {
+		return nil, err
+	}
+	if clientAuth == tls.NoClientCert {
+		return nil, nil
+	}
+
+	role, err := auth.ParseRole(specs.ClientRole)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS_CLIENT_ROLE: %w", err)
+	}
+
+	return auth.NewCertAuthenticator(auth.CertConfig{Identity: specs.ClientIdentity, Role: role}, log)
+}
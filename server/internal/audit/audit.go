@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+
+	"github.com/vkumov/go-pxgrider/server/internal/auth"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
+	"github.com/vkumov/go-pxgrider/server/internal/logger"
+)
+
+const systemActor = "system"
+
+type (
+	// Event is a recorded call of a method changing state
+	Event struct {
+		ID           int64
+		CreatedAt    time.Time
+		Actor        string
+		TokenID      string
+		UID          string
+		Method       string
+		ConnectionID string
+		Peer         string
+		Code         string
+		Error        string
+		Details      json.RawMessage
+	}
+
+	// Recorder stores audit events in the audit_events table
+	Recorder struct {
+		db  *sql.DB
+		log *zerolog.Logger
+	}
+
+	// entry collects details added by the handler while the call is served
+	entry struct {
+		sync.Mutex
+		details map[string]any
+	}
+
+	entryKey struct{}
+
+	connectionIDRequest interface {
+		GetConnectionId() string
+	}
+
+	userRequest interface {
+		GetUser() *pb.User
+	}
+)
+
+// connectionMethods are the methods with the connection in the id field
+var connectionMethods = map[string]struct{}{
+	pb.PxgriderService_UpdateConnection_FullMethodName:  {},
+	pb.PxgriderService_DeleteConnection_FullMethodName:  {},
+	pb.PxgriderService_RefreshConnection_FullMethodName: {},
+}
+
+func NewRecorder(db *sql.DB, log *zerolog.Logger) *Recorder {
+	return &Recorder{db: db, log: log}
+}
+
+// Audited reports whether calls of the method are recorded, that is every method
+// which requires more than viewing
+func Audited(method string) bool {
+	return auth.MethodRole(method) > auth.RoleViewer
+}
+
+// UnaryInterceptor records audited calls after they are handled, it must run
+// after the authenticator to know the caller
+func (r *Recorder) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !Audited(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	e := &entry{details: make(map[string]any)}
+	resp, err := handler(context.WithValue(ctx, entryKey{}, e), req)
+
+	r.record(ctx, info.FullMethod, req, resp, err, e)
+
+	return resp, err
+}
+
+// Set adds a detail to the audit event of the call, ignored for calls which aren't audited
+func Set(ctx context.Context, key string, v any) {
+	e, ok := ctx.Value(entryKey{}).(*entry)
+	if !ok {
+		return
+	}
+
+	e.Lock()
+	e.details[key] = v
+	e.Unlock()
+}
+
+func (r *Recorder) record(ctx context.Context, method string, req, resp any, callErr error, e *entry) {
+	ev := &Event{
+		Method: method,
+		Code:   status.Code(callErr).String(),
+	}
+	if callErr != nil {
+		ev.Error = callErr.Error()
+	}
+
+	if p, ok := auth.FromContext(ctx); ok {
+		ev.Actor, ev.TokenID = p.UID, p.TokenID
+		if p.System {
+			ev.Actor = systemActor
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ev.Peer = p.Addr.String()
+	}
+	if u, ok := req.(userRequest); ok {
+		ev.UID = u.GetUser().GetUid()
+	}
+	ev.ConnectionID = connectionID(method, req, resp)
+
+	e.Lock()
+	if m, ok := req.(proto.Message); ok {
+		if data, err := protojson.Marshal(withoutSinkSecrets(m)); err == nil {
+			e.details["request"] = json.RawMessage(data)
+		}
+	}
+	details, err := json.Marshal(logger.Redact(e.details))
+	e.Unlock()
+	if err != nil {
+		r.log.Error().Err(err).Str("method", method).Msg("failed to marshal audit details")
+	}
+	ev.Details = details
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := r.Record(ctx, ev); err != nil {
+		r.log.Error().Err(err).Str("method", method).Str("actor", ev.Actor).Msg("failed to record audit event")
+	}
+}
+
+// withoutSinkSecrets returns a copy of the request with the secrets of its
+// sinks cleared, their custom headers have names which can't be told secret
+func withoutSinkSecrets(m proto.Message) proto.Message {
+	m = proto.Clone(m)
+	clearSinkSecrets(m.ProtoReflect())
+	return m
+}
+
+func clearSinkSecrets(m protoreflect.Message) {
+	if s, ok := m.Interface().(*pb.Sink); ok {
+		forwarder.ClearSecrets(s)
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil:
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				clearSinkSecrets(v.List().Get(i).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					clearSinkSecrets(mv.Message())
+					return true
+				})
+			}
+		default:
+			clearSinkSecrets(v.Message())
+		}
+		return true
+	})
+}
+
+func connectionID(method string, req, resp any) string {
+	if r, ok := req.(connectionIDRequest); ok {
+		return r.GetConnectionId()
+	}
+	if _, ok := connectionMethods[method]; ok {
+		if r, ok := req.(interface{ GetId() string }); ok {
+			return r.GetId()
+		}
+	}
+	if r, ok := resp.(interface{ GetConnection() *pb.Connection }); ok {
+		return r.GetConnection().GetId()
+	}
+	return ""
+}
@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// Filter selects audit events, zero fields match everything
+type Filter struct {
+	UID          string
+	ConnectionID string
+	Actor        string
+	Method       string
+	Since        time.Time
+	Until        time.Time
+	ErrorsOnly   bool
+	Limit        int64
+	Offset       int64
+}
+
+func (r *Recorder) Record(ctx context.Context, e *Event) error {
+	var details any
+	if len(e.Details) > 0 {
+		details = string(e.Details)
+	}
+
+	return r.db.QueryRowContext(ctx,
+		`INSERT INTO audit_events (actor, token_id, uid, method, connection_id, peer, code, error, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id, created_at`,
+		e.Actor, e.TokenID, e.UID, e.Method, e.ConnectionID, e.Peer, e.Code, e.Error, details,
+	).Scan(&e.ID, &e.CreatedAt)
+}
+
+// Query returns the newest events matching the filter and the total of matching events
+func (r *Recorder) Query(ctx context.Context, f Filter) ([]*Event, int64, error) {
+	var (
+		where []string
+		args  []any
+	)
+	add := func(cond string, v any) {
+		args = append(args, v)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.UID != "" {
+		add("uid = $%d", f.UID)
+	}
+	if f.ConnectionID != "" {
+		add("connection_id = $%d", f.ConnectionID)
+	}
+	if f.Actor != "" {
+		add("actor = $%d", f.Actor)
+	}
+	if f.Method != "" {
+		add("method = $%d", f.Method)
+	}
+	if !f.Since.IsZero() {
+		add("created_at >= $%d", f.Since)
+	}
+	if !f.Until.IsZero() {
+		add("created_at < $%d", f.Until)
+	}
+	if f.ErrorsOnly {
+		where = append(where, "error <> ''")
+	}
+
+	cond := ""
+	if len(where) > 0 {
+		cond = " WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT count(*) FROM audit_events"+cond, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	limit = min(limit, maxLimit)
+	args = append(args, limit, max(f.Offset, 0))
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, created_at, actor, token_id, uid, method, connection_id, peer, code, error, COALESCE(CAST(details AS text), '')
+		FROM audit_events`+cond+fmt.Sprintf(" ORDER BY id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var res []*Event
+	for rows.Next() {
+		var (
+			e       Event
+			details string
+		)
+		if err := rows.Scan(&e.ID, &e.CreatedAt, &e.Actor, &e.TokenID, &e.UID, &e.Method, &e.ConnectionID,
+			&e.Peer, &e.Code, &e.Error, &details); err != nil {
+			return nil, 0, err
+		}
+		if details != "" {
+			e.Details = json.RawMessage(details)
+		}
+		res = append(res, &e)
+	}
+
+	return res, total, rows.Err()
+}
+
+func (e *Event) ToProto() *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:           e.ID,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+		Actor:        e.Actor,
+		TokenId:      e.TokenID,
+		User:         &pb.User{Uid: e.UID},
+		Method:       e.Method,
+		ConnectionId: e.ConnectionID,
+		Peer:         e.Peer,
+		Code:         e.Code,
+		Error:        e.Error,
+		DetailsJson:  string(e.Details),
+	}
+}
@@ -7,64 +7,244 @@ import (
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
 )
 
 var (
-	ErrEmptyMetadata = errors.New("metadata is empty")
-	ErrAccessDenied  = errors.New("access denied")
+	// ErrEmptyMetadata and ErrAccessDenied are returned for missing or invalid
+	// credentials, ErrUserMismatch for a valid one used on behalf of another user
+	ErrEmptyMetadata = status.Error(codes.Unauthenticated, "metadata is empty")
+	ErrAccessDenied  = status.Error(codes.Unauthenticated, "access denied")
+	ErrUserMismatch  = status.Error(codes.PermissionDenied, "token doesn't belong to the user")
+
+	// errNoCredentials is returned by resolvers when the call carries no credentials they handle
+	errNoCredentials = errors.New("no credentials")
 )
 
 type (
+	// Principal is the identity bound to the credential of a call
+	Principal struct {
+		// UID is the user the token belongs to, empty for the shared token
+		UID     string
+		Role    Role
+		TokenID string
+		// System is set for the shared AUTH_TOKEN, which may act on behalf of any user
+		System bool
+		// BindUser replaces the user of requests with UID instead of rejecting other users
+		BindUser bool
+	}
+
+	// resolver finds the principal of a call from its metadata
+	resolver interface {
+		resolve(ctx context.Context, md metadata.MD) (*Principal, error)
+	}
+
+	// interceptor authenticates calls with the first resolver recognizing the
+	// credentials and authorizes them by role
+	interceptor struct {
+		resolvers []resolver
+		log       *zerolog.Logger
+	}
+
 	tokenAuth struct {
 		token string
+		store *Store
 		log   *zerolog.Logger
 	}
 
 	Authenticator interface {
-		StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error
-		UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error)
+		StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error
+		UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error)
 	}
+
+	// userRequest is implemented by every request carrying the user it is made for
+	userRequest interface {
+		GetUser() *pb.User
+	}
+
+	// boundStream checks the user of every message received on the stream
+	boundStream struct {
+		grpc.ServerStream
+		ctx context.Context
+		p   *Principal
+	}
+
+	principalKey struct{}
 )
 
-var _ Authenticator = (*tokenAuth)(nil)
+var _ Authenticator = (*interceptor)(nil)
 
-func NewTokenAuthenticator(token string, log *zerolog.Logger) Authenticator {
-	a := &tokenAuth{log: log, token: token}
-	return a
+// NewTokenAuthenticator authenticates calls by the "token" metadata, which is either
+// the shared token or an API token from the store. The shared token is disabled if empty.
+func NewTokenAuthenticator(token string, store *Store, log *zerolog.Logger) Authenticator {
+	a := &tokenAuth{log: log, token: token, store: store}
+	return &interceptor{resolvers: []resolver{a}, log: log}
 }
 
-func (a *tokenAuth) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	if err := a.authorize(stream.Context()); err != nil {
+// Combine accepts the credentials of any of the authenticators, tried in order
+func Combine(log *zerolog.Logger, auths ...Authenticator) Authenticator {
+	c := &interceptor{log: log}
+	for _, a := range auths {
+		if i, ok := a.(*interceptor); ok {
+			c.resolvers = append(c.resolvers, i.resolvers...)
+		}
+	}
+	return c
+}
+
+func (a *interceptor) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	p, err := a.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
 		return err
 	}
 
-	a.log.Debug().Msg("stream interceptor")
-	return handler(srv, stream)
+	a.log.Debug().Str("method", info.FullMethod).Str("uid", p.UID).Msg("stream interceptor")
+	ctx := NewContext(stream.Context(), p)
+	return handler(srv, &boundStream{ServerStream: stream, ctx: ctx, p: p})
 }
 
-func (a *tokenAuth) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-	if err := a.authorize(ctx); err != nil {
+func (a *interceptor) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	p, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.bind(req); err != nil {
 		return nil, err
 	}
 
-	a.log.Debug().Msg("unary interceptor")
-	return handler(ctx, req)
+	a.log.Debug().Str("method", info.FullMethod).Str("uid", p.UID).Msg("unary interceptor")
+	return handler(NewContext(ctx, p), req)
 }
 
-func (a tokenAuth) authorize(ctx context.Context) error {
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		token, ok := md["token"]
-		if !ok || len(token) == 0 {
-			return ErrAccessDenied
+// authorize resolves the principal of the call and checks its role allows the method
+func (a *interceptor) authorize(ctx context.Context, method string) (*Principal, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ErrEmptyMetadata
+	}
+
+	var p *Principal
+	for _, r := range a.resolvers {
+		var err error
+		p, err = r.resolve(ctx, md)
+		if errors.Is(err, errNoCredentials) {
+			continue
+		}
+		if err != nil {
+			return nil, err
 		}
+		break
+	}
+	if p == nil {
+		return nil, ErrAccessDenied
+	}
 
-		if subtle.ConstantTimeCompare([]byte(token[0]), []byte(a.token)) == 1 {
-			return nil
+	if required := MethodRole(method); p.Role < required {
+		a.log.Warn().Str("method", method).Str("uid", p.UID).Str("token", p.TokenID).Stringer("role", p.Role).
+			Msg("role doesn't allow the method")
+		return nil, errRoleRequired(required)
+	}
+
+	return p, nil
+}
+
+func (a *tokenAuth) resolve(ctx context.Context, md metadata.MD) (*Principal, error) {
+	token := md.Get("token")
+	if len(token) == 0 || token[0] == "" {
+		return nil, errNoCredentials
+	}
+
+	if a.token != "" && subtle.ConstantTimeCompare([]byte(token[0]), []byte(a.token)) == 1 {
+		return &Principal{Role: RoleAdmin, System: true}, nil
+	}
+
+	t, err := a.store.Lookup(ctx, token[0])
+	if err != nil {
+		if !errors.Is(err, ErrTokenNotFound) && !errors.Is(err, ErrTokenExpired) {
+			a.log.Error().Err(err).Msg("failed to look up token")
 		}
+		return nil, ErrAccessDenied
+	}
+
+	return &Principal{UID: t.UID, Role: t.Role, TokenID: t.ID}, nil
+}
+
+// bind makes sure the request is made on behalf of the user of the principal,
+// either by replacing its user or by rejecting other users
+func (p *Principal) bind(req any) error {
+	if p.System {
+		return nil
+	}
+
+	r, ok := req.(userRequest)
+	if !ok {
+		return nil
+	}
+	if p.BindUser {
+		return setUser(req, p.UID)
+	}
+	if r.GetUser().GetUid() != p.UID {
+		return ErrUserMismatch
+	}
+
+	return nil
+}
+
+// setUser replaces the user field of the request message
+func setUser(req any, uid string) error {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	rm := m.ProtoReflect()
+	fd := rm.Descriptor().Fields().ByName("user")
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != (&pb.User{}).ProtoReflect().Descriptor().FullName() {
+		return errors.New("request has no user field")
+	}
+	rm.Set(fd, protoreflect.ValueOfMessage((&pb.User{Uid: uid}).ProtoReflect()))
+
+	return nil
+}
+
+func (s *boundStream) Context() context.Context {
+	return s.ctx
+}
 
+func (s *boundStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.p.bind(m)
+}
+
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// Require returns an error unless the principal of the call has at least the role
+func Require(ctx context.Context, role Role) error {
+	p, ok := FromContext(ctx)
+	if !ok {
 		return ErrAccessDenied
 	}
+	if p.Role < role {
+		return errRoleRequired(role)
+	}
+	return nil
+}
 
-	return ErrEmptyMetadata
+func errRoleRequired(role Role) error {
+	return status.Errorf(codes.PermissionDenied, "%s role required", role)
 }
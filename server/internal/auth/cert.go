@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type (
+	// CertConfig maps verified client certificates to users. Identity is the
+	// certificate field holding the user UID: cn, subject, email, dns or uri, the
+	// SAN ones use the first entry.
+	CertConfig struct {
+		Identity string
+		Role     Role
+	}
+
+	certAuth struct {
+		cfg CertConfig
+		log *zerolog.Logger
+	}
+)
+
+// NewCertAuthenticator authenticates calls by the client certificate verified
+// during the TLS handshake. Like bearer tokens, the user sent in requests is ignored.
+func NewCertAuthenticator(cfg CertConfig, log *zerolog.Logger) (Authenticator, error) {
+	if cfg.Identity == "" {
+		cfg.Identity = "cn"
+	}
+	if _, err := certIdentity(&x509.Certificate{}, cfg.Identity); err != nil {
+		return nil, err
+	}
+	if cfg.Role == RoleNone {
+		return nil, errors.New("client certificates require a role")
+	}
+
+	a := &certAuth{cfg: cfg, log: log}
+	return &interceptor{resolvers: []resolver{a}, log: log}, nil
+}
+
+func (a *certAuth) resolve(ctx context.Context, _ metadata.MD) (*Principal, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errNoCredentials
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, errNoCredentials
+	}
+
+	leaf := info.State.VerifiedChains[0][0]
+	uid, _ := certIdentity(leaf, a.cfg.Identity)
+	if uid == "" {
+		a.log.Debug().Str("subject", leaf.Subject.String()).Str("identity", a.cfg.Identity).
+			Msg("client certificate has no identity")
+		return nil, ErrAccessDenied
+	}
+
+	return &Principal{UID: uid, Role: a.cfg.Role, TokenID: leaf.SerialNumber.Text(16), BindUser: true}, nil
+}
+
+func certIdentity(c *x509.Certificate, field string) (string, error) {
+	switch field {
+	case "cn":
+		return c.Subject.CommonName, nil
+	case "subject":
+		if len(c.Subject.Names) == 0 {
+			return "", nil
+		}
+		return c.Subject.String(), nil
+	case "email":
+		if len(c.EmailAddresses) == 0 {
+			return "", nil
+		}
+		return c.EmailAddresses[0], nil
+	case "dns":
+		if len(c.DNSNames) == 0 {
+			return "", nil
+		}
+		return c.DNSNames[0], nil
+	case "uri":
+		if len(c.URIs) == 0 {
+			return "", nil
+		}
+		return c.URIs[0].String(), nil
+	default:
+		return "", fmt.Errorf("unknown client certificate identity %q", field)
+	}
+}
@@ -0,0 +1,381 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultUIDClaim        = "sub"
+	defaultJWKSRefresh     = time.Hour
+	minJWKSRefreshInterval = 30 * time.Second
+	jwksFetchTimeout       = 10 * time.Second
+)
+
+type (
+	// JWTConfig validates bearer tokens signed by the keys of a JWKS
+	JWTConfig struct {
+		// JWKS is a file path or an http(s) URL of the key set
+		JWKS     string
+		Issuer   string
+		Audience string
+		// UIDClaim is the claim holding the user UID, "sub" if empty
+		UIDClaim string
+		// RoleClaim is the claim holding the role or the list of roles, the highest
+		// known one is used. DefaultRole applies when there is none.
+		RoleClaim       string
+		DefaultRole     Role
+		RefreshInterval time.Duration
+		Leeway          time.Duration
+	}
+
+	jwtAuth struct {
+		cfg    JWTConfig
+		keys   *jwks
+		parser *jwt.Parser
+		log    *zerolog.Logger
+	}
+
+	// jwks caches the keys of the key set by kid, it's reloaded periodically and
+	// when a token is signed by an unknown key. Reloads are fetched by a single
+	// caller without holding mu, at most once per minJWKSRefreshInterval.
+	jwks struct {
+		source   string
+		interval time.Duration
+		group    singleflight.Group
+
+		mu       sync.Mutex
+		keys     map[string]crypto.PublicKey
+		loadedAt time.Time
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		Crv string `json:"crv"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+)
+
+// NewJWTAuthenticator authenticates calls by the "authorization: Bearer <jwt>" metadata.
+// The user of the call is taken from the token, the user sent in requests is ignored.
+func NewJWTAuthenticator(cfg JWTConfig, log *zerolog.Logger) (Authenticator, error) {
+	if cfg.JWKS == "" {
+		return nil, errors.New("jwt authentication requires a jwks")
+	}
+	if cfg.UIDClaim == "" {
+		cfg.UIDClaim = defaultUIDClaim
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultJWKSRefresh
+	}
+
+	keys := &jwks{source: cfg.JWKS, interval: cfg.RefreshInterval}
+	if err := keys.load(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to load jwks: %w", err)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512",
+			"ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	a := &jwtAuth{cfg: cfg, keys: keys, parser: jwt.NewParser(opts...), log: log}
+	return &interceptor{resolvers: []resolver{a}, log: log}, nil
+}
+
+func (a *jwtAuth) resolve(ctx context.Context, md metadata.MD) (*Principal, error) {
+	h := md.Get("authorization")
+	if len(h) == 0 {
+		return nil, errNoCredentials
+	}
+	scheme, raw, ok := strings.Cut(h[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return nil, errNoCredentials
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(strings.TrimSpace(raw), claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.keys.get(ctx, kid)
+	})
+	if err != nil {
+		a.log.Debug().Err(err).Msg("invalid bearer token")
+		return nil, ErrAccessDenied
+	}
+
+	uid, _ := claims[a.cfg.UIDClaim].(string)
+	if uid == "" {
+		a.log.Debug().Str("claim", a.cfg.UIDClaim).Msg("bearer token has no user claim")
+		return nil, ErrAccessDenied
+	}
+
+	role := a.role(claims)
+	if role == RoleNone {
+		return nil, ErrAccessDenied
+	}
+
+	jti, _ := claims["jti"].(string)
+	return &Principal{UID: uid, Role: role, TokenID: jti, BindUser: true}, nil
+}
+
+// role returns the highest known role of the role claim, which may be a string,
+// a space separated string or a list
+func (a *jwtAuth) role(claims jwt.MapClaims) Role {
+	if a.cfg.RoleClaim == "" {
+		return a.cfg.DefaultRole
+	}
+
+	var names []string
+	switch v := claims[a.cfg.RoleClaim].(type) {
+	case string:
+		names = strings.Fields(v)
+	case []any:
+		for _, n := range v {
+			if s, ok := n.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+
+	found := false
+	res := RoleNone
+	for _, n := range names {
+		r, err := ParseRole(n)
+		if err != nil {
+			continue
+		}
+		found = true
+		if r > res {
+			res = r
+		}
+	}
+	if !found {
+		return a.cfg.DefaultRole
+	}
+
+	return res
+}
+
+func (k *jwks) get(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k.mu.Lock()
+	stale := time.Since(k.loadedAt) > k.interval
+	k.mu.Unlock()
+
+	if stale {
+		_ = k.load(ctx)
+	}
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	// the key set may have been rotated, load is rate limited so unknown kids
+	// don't hammer the source
+	if err := k.load(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+// lookup returns the key by kid, tokens without kid are accepted only if the set has a single key
+func (k *jwks) lookup(kid string) (crypto.PublicKey, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+// load reloads the key set unless it was tried less than minJWKSRefreshInterval
+// ago. Concurrent callers share the fetch, which isn't cancelled if one of them goes away.
+func (k *jwks) load(ctx context.Context) error {
+	ch := k.group.DoChan("", func() (any, error) {
+		k.mu.Lock()
+		if !k.loadedAt.IsZero() && time.Since(k.loadedAt) < minJWKSRefreshInterval {
+			k.mu.Unlock()
+			return nil, nil
+		}
+		// loadedAt is bumped on failures as well to rate limit retries
+		k.loadedAt = time.Now()
+		k.mu.Unlock()
+
+		keys, err := k.fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		k.mu.Lock()
+		k.keys = keys
+		k.mu.Unlock()
+		return nil, nil
+	})
+
+	select {
+	case res := <-ch:
+		return res.Err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (k *jwks) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	data, err := k.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks has no signing keys")
+	}
+
+	return keys, nil
+}
+
+func (k *jwks) read(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(k.source, "http://") && !strings.HasPrefix(k.source, "https://") {
+		return os.ReadFile(k.source)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, jwksFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks request failed: %s", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+func (j *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBigInt(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(j.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("invalid rsa exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := decodeBigInt(j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(j.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testKeys struct {
+	rsa *rsa.PrivateKey
+	ec  *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+
+	rk, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ek, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testKeys{rsa: rk, ec: ek}
+}
+
+func (k testKeys) jwks() []byte {
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+	set := map[string][]jsonWebKey{"keys": {
+		{
+			Kty: "RSA", Kid: "rsa", Use: "sig",
+			N: b64(k.rsa.N.Bytes()),
+			E: b64(big.NewInt(int64(k.rsa.E)).Bytes()),
+		},
+		{
+			Kty: "EC", Kid: "ec", Crv: "P-256",
+			X: b64(k.ec.X.FillBytes(make([]byte, 32))),
+			Y: b64(k.ec.Y.FillBytes(make([]byte, 32))),
+		},
+	}}
+
+	data, _ := json.Marshal(set)
+	return data
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	t.Helper()
+
+	tok := jwt.NewWithClaims(method, claims)
+	tok.Header["kid"] = kid
+	s, err := tok.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func claims(aud string, exp time.Duration) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"sub":   "alice",
+		"aud":   aud,
+		"iat":   now.Unix(),
+		"exp":   now.Add(exp).Unix(),
+		"roles": []string{"viewer", "operator"},
+	}
+}
+
+func newTestJWTAuth(t *testing.T, source string) *jwtAuth {
+	t.Helper()
+
+	log := zerolog.Nop()
+	a, err := NewJWTAuthenticator(JWTConfig{
+		JWKS:        source,
+		Audience:    "pxgrider",
+		RoleClaim:   "roles",
+		DefaultRole: RoleViewer,
+	}, &log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.(*interceptor).resolvers[0].(*jwtAuth)
+}
+
+func bearer(token string) metadata.MD {
+	return metadata.Pairs("authorization", "Bearer "+token)
+}
+
+func TestJWTAuthentication(t *testing.T) {
+	keys := newTestKeys(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(keys.jwks())
+	}))
+	defer srv.Close()
+
+	a := newTestJWTAuth(t, srv.URL)
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"RS256", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, claims("pxgrider", time.Hour)), true},
+		{"ES256", sign(t, jwt.SigningMethodES256, "ec", keys.ec, claims("pxgrider", time.Hour)), true},
+		{"expired", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, claims("pxgrider", -time.Minute)), false},
+		{"wrong audience", sign(t, jwt.SigningMethodES256, "ec", keys.ec, claims("other", time.Hour)), false},
+		{"unknown kid", sign(t, jwt.SigningMethodRS256, "unknown", other, claims("pxgrider", time.Hour)), false},
+		{"wrong key", sign(t, jwt.SigningMethodRS256, "rsa", other, claims("pxgrider", time.Hour)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := a.resolve(context.Background(), bearer(tt.token))
+			if !tt.valid {
+				if status.Code(err) != codes.Unauthenticated {
+					t.Fatalf("err = %v, want Unauthenticated", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.UID != "alice" || p.Role != RoleOperator || !p.BindUser {
+				t.Errorf("unexpected principal %+v", p)
+			}
+		})
+	}
+
+	if _, err := a.resolve(context.Background(), metadata.MD{}); !errors.Is(err, errNoCredentials) {
+		t.Errorf("err = %v, want no credentials", err)
+	}
+}
+
+func TestJWKSRefreshIsShared(t *testing.T) {
+	keys := newTestKeys(t)
+
+	var (
+		requests atomic.Int32
+		release  = make(chan struct{})
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) > 1 {
+			<-release
+		}
+		_, _ = w.Write(keys.jwks())
+	}))
+	defer srv.Close()
+
+	a := newTestJWTAuth(t, srv.URL)
+	// let the unknown kids below trigger a refresh
+	a.keys.mu.Lock()
+	a.keys.loadedAt = time.Now().Add(-minJWKSRefreshInterval)
+	a.keys.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := a.keys.get(context.Background(), "unknown"); err == nil {
+				t.Error("unknown kid is accepted")
+			}
+		}()
+	}
+
+	// known keys are served while the refresh is in flight
+	waitUntil(t, func() bool { return requests.Load() == 2 })
+	if _, err := a.keys.get(context.Background(), "rsa"); err != nil {
+		t.Errorf("known kid during refresh: %v", err)
+	}
+	close(release)
+	wg.Wait()
+
+	if _, err := a.keys.get(context.Background(), "unknown"); err == nil {
+		t.Error("unknown kid is accepted")
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("jwks fetched %d times, want 2", n)
+	}
+}
+
+func waitUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
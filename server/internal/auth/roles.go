@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"fmt"
+
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+)
+
+// Role is ordered, every role is allowed what the lower ones are
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleOperator
+	RoleAdmin
+)
+
+// methodRoles is the lowest role allowed to call each RPC, methods not listed
+// require RoleAdmin
+var methodRoles = map[string]Role{
+	healthgrpc.Health_Check_FullMethodName: RoleViewer,
+	healthgrpc.Health_Watch_FullMethodName: RoleViewer,
+
+	pb.PxgriderService_CheckFQDN_FullMethodName:                RoleViewer,
+	pb.PxgriderService_GetConnections_FullMethodName:           RoleViewer,
+	pb.PxgriderService_GetConnectionsTotal_FullMethodName:      RoleViewer,
+	pb.PxgriderService_GetConnection_FullMethodName:            RoleViewer,
+	pb.PxgriderService_GetConnectionSinks_FullMethodName:       RoleViewer,
+	pb.PxgriderService_GetAllSubscriptions_FullMethodName:      RoleViewer,
+	pb.PxgriderService_GetSubscription_FullMethodName:          RoleViewer,
+	pb.PxgriderService_GetConnectionMessages_FullMethodName:    RoleViewer,
+	pb.PxgriderService_StreamConnectionMessages_FullMethodName: RoleViewer,
+	pb.PxgriderService_ExportConnectionMessages_FullMethodName: RoleViewer,
+	pb.PxgriderService_GetConnectionLogs_FullMethodName:        RoleViewer,
+	pb.PxgriderService_GetConnectionServices_FullMethodName:    RoleViewer,
+	pb.PxgriderService_GetConnectionService_FullMethodName:     RoleViewer,
+	pb.PxgriderService_GetServiceMethods_FullMethodName:        RoleViewer,
+	pb.PxgriderService_GetConnectionTopics_FullMethodName:      RoleViewer,
+	pb.PxgriderService_GetServiceTopics_FullMethodName:         RoleViewer,
+
+	// UpdateConnection changing credentials or owner requires RoleAdmin, checked by the handler
+	pb.PxgriderService_UpdateConnection_FullMethodName:             RoleOperator,
+	pb.PxgriderService_SetConnectionSinks_FullMethodName:           RoleOperator,
+	pb.PxgriderService_MarkConnectionMessagesAsRead_FullMethodName: RoleOperator,
+	pb.PxgriderService_SetConnectionLogLevel_FullMethodName:        RoleOperator,
+	pb.PxgriderService_RefreshConnection_FullMethodName:            RoleOperator,
+	pb.PxgriderService_SubscribeConnection_FullMethodName:          RoleOperator,
+	pb.PxgriderService_SetSubscriptionSinks_FullMethodName:         RoleOperator,
+	pb.PxgriderService_UnsubscribeConnection_FullMethodName:        RoleOperator,
+	pb.PxgriderService_DeleteConnectionMessages_FullMethodName:     RoleOperator,
+	pb.PxgriderService_DeleteConnectionLogs_FullMethodName:         RoleOperator,
+	pb.PxgriderService_CallServiceMethod_FullMethodName:            RoleOperator,
+	pb.PxgriderService_ServiceLookup_FullMethodName:                RoleOperator,
+	pb.PxgriderService_ServiceCheckNodes_FullMethodName:            RoleOperator,
+	pb.PxgriderService_RefreshAccountState_FullMethodName:          RoleOperator,
+
+	pb.PxgriderService_CreateConnection_FullMethodName:     RoleAdmin,
+	pb.PxgriderService_DeleteConnection_FullMethodName:     RoleAdmin,
+	pb.PxgriderService_ServiceUpdateSecrets_FullMethodName: RoleAdmin,
+	pb.PxgriderService_CreateApiToken_FullMethodName:       RoleAdmin,
+	pb.PxgriderService_ListApiTokens_FullMethodName:        RoleAdmin,
+	pb.PxgriderService_RevokeApiToken_FullMethodName:       RoleAdmin,
+	pb.PxgriderService_GetAuditEvents_FullMethodName:       RoleAdmin,
+}
+
+// MethodRole returns the lowest role allowed to call the RPC
+func MethodRole(method string) Role {
+	if r, ok := methodRoles[method]; ok {
+		return r
+	}
+	return RoleAdmin
+}
+
+func ParseRole(s string) (Role, error) {
+	switch s {
+	case "viewer":
+		return RoleViewer, nil
+	case "operator":
+		return RoleOperator, nil
+	case "admin":
+		return RoleAdmin, nil
+	default:
+		return RoleNone, fmt.Errorf("unknown role %q", s)
+	}
+}
+
+func (r Role) String() string {
+	switch r {
+	case RoleViewer:
+		return "viewer"
+	case RoleOperator:
+		return "operator"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "none"
+	}
+}
+
+func RoleFromProto(r pb.Role) (Role, error) {
+	switch r {
+	case pb.Role_ROLE_VIEWER:
+		return RoleViewer, nil
+	case pb.Role_ROLE_OPERATOR:
+		return RoleOperator, nil
+	case pb.Role_ROLE_ADMIN:
+		return RoleAdmin, nil
+	default:
+		return RoleNone, fmt.Errorf("unknown role %s", r)
+	}
+}
+
+func (r Role) ToProto() pb.Role {
+	switch r {
+	case RoleViewer:
+		return pb.Role_ROLE_VIEWER
+	case RoleOperator:
+		return pb.Role_ROLE_OPERATOR
+	case RoleAdmin:
+		return pb.Role_ROLE_ADMIN
+	default:
+		return pb.Role_ROLE_UNSPECIFIED
+	}
+}
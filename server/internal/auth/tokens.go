@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+)
+
+const (
+	tokenPrefix = "pxg_"
+
+	// last_used_at is updated at most once per interval to spare writes on every RPC
+	lastUsedInterval = time.Minute
+)
+
+var (
+	ErrTokenNotFound = errors.New("token not found")
+	ErrTokenExpired  = errors.New("token expired")
+)
+
+type (
+	// Token is an API token of a user, only its hash is stored
+	Token struct {
+		ID         string
+		UID        string
+		Name       string
+		Role       Role
+		CreatedAt  time.Time
+		LastUsedAt sql.NullTime
+		ExpiresAt  sql.NullTime
+	}
+
+	// Store keeps API tokens in the api_tokens table
+	Store struct {
+		db *sql.DB
+	}
+)
+
+func NewStore(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// Create issues a new token, the returned secret is shown once and can't be recovered
+func (s *Store) Create(ctx context.Context, uid, name string, role Role, expiresAt sql.NullTime) (*Token, string, error) {
+	if uid == "" {
+		return nil, "", errors.New("token requires a user")
+	}
+	if role == RoleNone {
+		return nil, "", errors.New("token requires a role")
+	}
+
+	id, err := randomString(9)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomString(32)
+	if err != nil {
+		return nil, "", err
+	}
+	secret = tokenPrefix + id + "_" + secret
+
+	t := &Token{ID: id, UID: uid, Name: name, Role: role, ExpiresAt: expiresAt}
+	err = s.db.QueryRowContext(ctx,
+		`INSERT INTO api_tokens (id, uid, name, role, token_hash, expires_at) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at`,
+		t.ID, t.UID, t.Name, t.Role.String(), hashToken(secret), t.ExpiresAt,
+	).Scan(&t.CreatedAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return t, secret, nil
+}
+
+// Lookup returns the token matching the secret if it isn't expired
+func (s *Store) Lookup(ctx context.Context, secret string) (*Token, error) {
+	if !strings.HasPrefix(secret, tokenPrefix) {
+		return nil, ErrTokenNotFound
+	}
+
+	t, err := scanToken(s.db.QueryRowContext(ctx,
+		`SELECT id, uid, name, role, created_at, last_used_at, expires_at FROM api_tokens WHERE token_hash = $1`,
+		hashToken(secret)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if t.ExpiresAt.Valid && now.After(t.ExpiresAt.Time) {
+		return nil, ErrTokenExpired
+	}
+
+	if !t.LastUsedAt.Valid || now.Sub(t.LastUsedAt.Time) > lastUsedInterval {
+		_, _ = s.db.ExecContext(ctx, `UPDATE api_tokens SET last_used_at = $1 WHERE id = $2`, now, t.ID)
+	}
+
+	return t, nil
+}
+
+func (s *Store) List(ctx context.Context, uid string) ([]*Token, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, uid, name, role, created_at, last_used_at, expires_at FROM api_tokens WHERE uid = $1
+		ORDER BY created_at`, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*Token
+	for rows.Next() {
+		t, err := scanToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+
+	return res, rows.Err()
+}
+
+// Revoke deletes the token of the user
+func (s *Store) Revoke(ctx context.Context, uid, id string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM api_tokens WHERE uid = $1 AND id = $2`, uid, id)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrTokenNotFound
+	}
+
+	return nil
+}
+
+func (t *Token) ToProto() *pb.ApiToken {
+	p := &pb.ApiToken{
+		Id:        t.ID,
+		User:      &pb.User{Uid: t.UID},
+		Name:      t.Name,
+		Role:      t.Role.ToProto(),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+	if t.LastUsedAt.Valid {
+		p.LastUsedAt = timestamppb.New(t.LastUsedAt.Time)
+	}
+	if t.ExpiresAt.Valid {
+		p.ExpiresAt = timestamppb.New(t.ExpiresAt.Time)
+	}
+
+	return p
+}
+
+func scanToken(row interface{ Scan(...any) error }) (*Token, error) {
+	var (
+		t    Token
+		role string
+	)
+	if err := row.Scan(&t.ID, &t.UID, &t.Name, &role, &t.CreatedAt, &t.LastUsedAt, &t.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if t.Role, err = ParseRole(role); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
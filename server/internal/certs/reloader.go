@@ -0,0 +1,193 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+)
+
+// reloadDelay coalesces the burst of events of a single certificate rotation
+const reloadDelay = 500 * time.Millisecond
+
+type (
+	// Reloader serves the server certificate and the client CA pool from disk and
+	// reloads them when the files change. Directories are watched rather than files
+	// to survive rotations by rename, as done for mounted Kubernetes secrets.
+	Reloader struct {
+		certFile     string
+		keyFile      string
+		clientCAFile string
+		clientAuth   tls.ClientAuthType
+		log          *zerolog.Logger
+
+		mu      sync.RWMutex
+		cert    *tls.Certificate
+		clients *x509.CertPool
+
+		watcher *fsnotify.Watcher
+		done    chan struct{}
+	}
+)
+
+// ParseClientAuth maps none, optional and require to the client certificate policy
+func ParseClientAuth(s string) (tls.ClientAuthType, error) {
+	switch s {
+	case "", "none":
+		return tls.NoClientCert, nil
+	case "optional":
+		return tls.VerifyClientCertIfGiven, nil
+	case "require":
+		return tls.RequireAndVerifyClientCert, nil
+	default:
+		return tls.NoClientCert, fmt.Errorf("unknown client auth %q", s)
+	}
+}
+
+// NewReloader loads the certificates and starts watching them. Client certificates
+// are verified against clientCAFile, which may only be empty with tls.NoClientCert.
+func NewReloader(certFile, keyFile, clientCAFile string, clientAuth tls.ClientAuthType, log *zerolog.Logger) (*Reloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("tls requires a certificate and a key")
+	}
+	if clientAuth != tls.NoClientCert && clientCAFile == "" {
+		return nil, errors.New("client certificate verification requires a client CA")
+	}
+
+	r := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+		clientAuth:   clientAuth,
+		log:          log,
+		done:         make(chan struct{}),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range r.dirs() {
+		if err := w.Add(dir); err != nil {
+			w.Close()
+			return nil, fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+	}
+	r.watcher = w
+
+	go r.watch()
+
+	return r, nil
+}
+
+// TLSConfig returns the server config, every handshake uses the latest certificates
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   r.clientAuth,
+				ClientCAs:    r.clients,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	}
+}
+
+func (r *Reloader) Close() error {
+	select {
+	case <-r.done:
+		return nil
+	default:
+		close(r.done)
+	}
+	return r.watcher.Close()
+}
+
+func (r *Reloader) load() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	var clients *x509.CertPool
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to load client CA: %w", err)
+		}
+		clients = x509.NewCertPool()
+		if !clients.AppendCertsFromPEM(pem) {
+			return errors.New("client CA file has no certificates")
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.clients = &cert, clients
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *Reloader) dirs() []string {
+	seen := map[string]bool{}
+	var res []string
+	for _, f := range []string{r.certFile, r.keyFile, r.clientCAFile} {
+		if f == "" {
+			continue
+		}
+		dir := filepath.Dir(f)
+		if !seen[dir] {
+			seen[dir] = true
+			res = append(res, dir)
+		}
+	}
+	return res
+}
+
+func (r *Reloader) watch() {
+	var timer <-chan time.Time
+	for {
+		select {
+		case <-r.done:
+			return
+		case e, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			if e.Has(fsnotify.Chmod) {
+				continue
+			}
+			timer = time.After(reloadDelay)
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			r.log.Error().Err(err).Msg("certificate watcher failed")
+		case <-timer:
+			timer = nil
+			// a half written rotation fails to load, the previous certificates stay
+			// in use until the next event
+			if err := r.load(); err != nil {
+				r.log.Error().Err(err).Msg("failed to reload certificates")
+				continue
+			}
+			r.log.Info().Msg("certificates reloaded")
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/vkumov/go-pxgrider/server/internal/connection"
+	"github.com/vkumov/go-pxgrider/server/internal/db"
+	"github.com/vkumov/go-pxgrider/server/internal/secrets"
+)
+
+// RunCommand runs a maintenance command instead of the server
+func (a *App) RunCommand(ctx context.Context, args []string) error {
+	switch args[0] {
+	case "reencrypt":
+		// re-encrypts stored credentials and sink secrets with the primary key, run
+		// after adding or rotating keys
+		report, err := connection.ReencryptCredentials(ctx, a.cfg.DB(), a.cfg.Dialect(), a.cfg.Logger())
+		if err != nil {
+			return err
+		}
+		a.cfg.Logger().Info().Int("total", report.Total).Int("rewritten", report.Rewritten).
+			Str("key", secrets.Current().Primary()).Msg("Credentials re-encrypted")
+		return nil
+	case "genkey":
+		// prints a new key entry for SECRETS_KEYS or SECRETS_KEY_FILE
+		if len(args) < 2 {
+			return errors.New("usage: genkey <key id>")
+		}
+		k, err := secrets.GenerateKey()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s:%s\n", args[1], k)
+		return nil
+	case "migrate":
+		return a.migrate(ctx, args[1:])
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// migrate runs migrate up, down [steps] or status
+func (a *App) migrate(ctx context.Context, args []string) error {
+	const usage = "usage: migrate up|down [steps]|status"
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	log := a.cfg.Logger()
+	switch args[0] {
+	case "up":
+		applied, err := db.MigrateUp(ctx, a.cfg.DB(), a.cfg.Dialect())
+		if err != nil {
+			return err
+		}
+		log.Info().Int("applied", len(applied)).Msg("Database migrated")
+		return nil
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return errors.New(usage)
+			}
+			steps = n
+		}
+		reverted, err := db.MigrateDown(ctx, a.cfg.DB(), a.cfg.Dialect(), steps)
+		if err != nil {
+			return err
+		}
+		log.Info().Int("reverted", len(reverted)).Msg("Database migrations reverted")
+		return nil
+	case "status":
+		status, err := db.MigrateStatus(ctx, a.cfg.DB(), a.cfg.Dialect())
+		if err != nil {
+			return err
+		}
+		for _, s := range status {
+			applied := "pending"
+			if !s.AppliedAt.IsZero() {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%s\t%s\n", s.Migration, applied)
+		}
+		return nil
+	default:
+		return errors.New(usage)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"sync"
 
 	"github.com/rs/zerolog"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db"
 )
 
 type AppConfig struct {
@@ -15,6 +17,7 @@ type AppConfig struct {
 	Specs Specs
 
 	db        *sql.DB
+	dialect   db.Dialect
 	logWriter io.Writer
 	l         *zerolog.Logger
 }
@@ -12,12 +12,12 @@ var _ shared.DBer = (*AppConfig)(nil)
 
 func (app *AppConfig) mustInitDB() *AppConfig {
 	dblogger := app.l.With().Str("component", "db").Logger()
-	db, err := db.NewSQL(context.Background(), app.Specs.DB, &dblogger)
+	conn, dialect, err := db.NewSQL(context.Background(), app.Specs.DB, &dblogger)
 	if err != nil {
 		panic(err)
 	}
 
-	app.db = db
+	app.db, app.dialect = conn, dialect
 	return app
 }
 
@@ -25,6 +25,10 @@ func (app *AppConfig) DB() *sql.DB {
 	return app.db
 }
 
+func (app *AppConfig) Dialect() db.Dialect {
+	return app.dialect
+}
+
 func (app *AppConfig) DBSpec() db.DBSpecs {
 	s := app.Specs.DB
 	return s
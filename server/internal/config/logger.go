@@ -32,7 +32,8 @@ func (app *AppConfig) buildLogger() *AppConfig {
 			Logger().
 			Level(level)
 
-		zerolog.SetGlobalLevel(level)
+		// not the global level, connections may log below the server level
+		log.Logger = log.Logger.Level(level)
 	} else {
 		// with colored output
 		app.logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
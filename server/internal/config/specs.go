@@ -20,12 +20,35 @@ var (
 )
 
 type (
+	// AuthSpecs Token is shared by trusted services, disabled if empty. It acts as
+	// admin on behalf of any user. Users authenticate with their own API tokens or
+	// with JWT bearer tokens if JWT.JWKS is set.
 	AuthSpecs struct {
 		Token string `env:"AUTH_TOKEN"`
+		JWT   JWTSpecs
 	}
 
+	// JWTSpecs JWKS is a file path or an URL of the key set. The user is taken from
+	// UIDClaim and the role from RoleClaim, DefaultRole applies to tokens without one.
+	JWTSpecs struct {
+		JWKS            string        `env:"AUTH_JWT_JWKS"`
+		Issuer          string        `env:"AUTH_JWT_ISSUER"`
+		Audience        string        `env:"AUTH_JWT_AUDIENCE"`
+		UIDClaim        string        `env:"AUTH_JWT_UID_CLAIM" default:"sub"`
+		RoleClaim       string        `env:"AUTH_JWT_ROLE_CLAIM" default:"roles"`
+		DefaultRole     string        `env:"AUTH_JWT_DEFAULT_ROLE" default:"viewer"`
+		RefreshInterval time.Duration `env:"AUTH_JWT_REFRESH_INTERVAL" default:"1h"`
+		Leeway          time.Duration `env:"AUTH_JWT_LEEWAY" default:"30s"`
+	}
+
+	// LoggerSpecs DBQueueSize bounds the log events of a connection waiting to be
+	// stored in the database. When it's full DBOverflow drop_oldest drops the
+	// oldest event, sample keeps one new event out of DBSampleRate.
 	LoggerSpecs struct {
-		Level string `env:"LOG_LEVEL" default:""`
+		Level        string `env:"LOG_LEVEL" default:""`
+		DBQueueSize  int    `env:"LOG_DB_QUEUE_SIZE" default:"1024"`
+		DBOverflow   string `env:"LOG_DB_OVERFLOW" default:"drop_oldest"`
+		DBSampleRate int    `env:"LOG_DB_SAMPLE_RATE" default:"10"`
 	}
 
 	KeepaliveSpecs struct {
@@ -41,10 +64,92 @@ type (
 		PermitWithoutStream bool          `env:"GRPC_PERMIT_WITHOUT_STREAM" default:"true"`
 	}
 
+	// TLSSpecs enable TLS if CertFile and KeyFile are set, the files are reloaded
+	// on change. ClientAuth is none, optional or require, client certificates are
+	// verified against ClientCAFile and mapped to the user by ClientIdentity: cn,
+	// subject, email, dns or uri.
+	TLSSpecs struct {
+		CertFile       string `env:"TLS_CERT_FILE"`
+		KeyFile        string `env:"TLS_KEY_FILE"`
+		ClientCAFile   string `env:"TLS_CLIENT_CA_FILE"`
+		ClientAuth     string `env:"TLS_CLIENT_AUTH" default:"none"`
+		ClientIdentity string `env:"TLS_CLIENT_IDENTITY" default:"cn"`
+		ClientRole     string `env:"TLS_CLIENT_ROLE" default:"viewer"`
+	}
+
+	// ServerSpecs ShutdownTimeout bounds the graceful stop on SIGINT or SIGTERM,
+	// calls still running after it are cancelled
 	ServerSpecs struct {
-		Port              int `env:"PORT" default:"50051"`
+		Port              int           `env:"PORT" default:"50051"`
+		ShutdownTimeout   time.Duration `env:"SHUTDOWN_TIMEOUT" default:"30s"`
 		Keepalive         KeepaliveSpecs
 		EnforcementPolicy EnforcementPolicySpecs
+		TLS               TLSSpecs
+	}
+
+	// RetentionSpecs limit stored messages and logs of every connection,
+	// zero means no limit
+	RetentionSpecs struct {
+		Interval        time.Duration `env:"RETENTION_INTERVAL" default:"1h"`
+		MessagesMaxAge  time.Duration `env:"RETENTION_MESSAGES_MAX_AGE"`
+		MessagesMaxRows int64         `env:"RETENTION_MESSAGES_MAX_ROWS"`
+		LogsMaxAge      time.Duration `env:"RETENTION_LOGS_MAX_AGE"`
+		LogsMaxRows     int64         `env:"RETENTION_LOGS_MAX_ROWS"`
+	}
+
+	// MetricsSpecs Listen is the address of the Prometheus metrics listener,
+	// disabled if empty
+	MetricsSpecs struct {
+		Listen string `env:"METRICS_LISTEN"`
+		Path   string `env:"METRICS_PATH" default:"/metrics"`
+	}
+
+	// TracingSpecs Exporter is none, stdout or otlp. OTLPEndpoint is the host:port of
+	// the OTLP gRPC collector, OTEL_EXPORTER_OTLP_* variables apply if it's empty.
+	// ShutdownTimeout bounds the flush of pending spans on shutdown, on top of
+	// the server and ingest ones.
+	TracingSpecs struct {
+		Exporter        string        `env:"TRACING_EXPORTER" default:"none"`
+		OTLPEndpoint    string        `env:"TRACING_OTLP_ENDPOINT"`
+		OTLPInsecure    bool          `env:"TRACING_OTLP_INSECURE"`
+		SampleRatio     float64       `env:"TRACING_SAMPLE_RATIO" default:"1"`
+		ServiceName     string        `env:"TRACING_SERVICE_NAME" default:"pxgrider"`
+		ShutdownTimeout time.Duration `env:"TRACING_SHUTDOWN_TIMEOUT" default:"5s"`
+	}
+
+	// SecretsSpecs enable encryption of stored credentials. Keys are "<id>:<base64
+	// 32 bytes key>" entries, comma separated inline or one per line in KeyFile.
+	// PrimaryKey encrypts new records, the last key if empty, the others are kept
+	// to decrypt records until they are re-encrypted. Without keys, credentials
+	// and sink secrets are stored in plain text, RequireEncryption refuses to
+	// start without keys instead.
+	SecretsSpecs struct {
+		Keys              string `env:"SECRETS_KEYS"`
+		KeyFile           string `env:"SECRETS_KEY_FILE"`
+		PrimaryKey        string `env:"SECRETS_PRIMARY_KEY"`
+		RequireEncryption bool   `env:"SECRETS_REQUIRE_ENCRYPTION"`
+	}
+
+	// ForwarderSpecs configure delivery of subscription messages to sinks.
+	// Undelivered messages are queued in QueueDir, "queue" under the data dir if
+	// empty, sinks can't be started if neither is set.
+	ForwarderSpecs struct {
+		QueueDir      string        `env:"FORWARDER_QUEUE_DIR"`
+		QueueMaxItems int           `env:"FORWARDER_QUEUE_MAX_ITEMS" default:"10000"`
+		RetryInterval time.Duration `env:"FORWARDER_RETRY_INTERVAL" default:"30s"`
+	}
+
+	// IngestSpecs batch inserts of messages and logs, a batch is flushed once
+	// BatchSize rows are queued or after FlushInterval. Producers block when
+	// QueueSize rows are waiting, batches are retried every RetryInterval while
+	// the database is unavailable. ShutdownTimeout bounds the final flush on
+	// shutdown, on top of the server one.
+	IngestSpecs struct {
+		BatchSize       int           `env:"INGEST_BATCH_SIZE" default:"500"`
+		FlushInterval   time.Duration `env:"INGEST_FLUSH_INTERVAL" default:"250ms"`
+		QueueSize       int           `env:"INGEST_QUEUE_SIZE" default:"10000"`
+		RetryInterval   time.Duration `env:"INGEST_RETRY_INTERVAL" default:"1s"`
+		ShutdownTimeout time.Duration `env:"INGEST_SHUTDOWN_TIMEOUT" default:"10s"`
 	}
 
 	VersionSpecs struct {
@@ -54,14 +159,23 @@ type (
 		V          string `ignored:"true"`
 	}
 
+	// Specs DataDir keeps state which must survive restarts, such as the
+	// forwarder queues
 	Specs struct {
 		sync.Mutex
-		Env     string `env:"ENV" default:"dev"`
-		Auth    AuthSpecs
-		DB      db.DBSpecs
-		Log     LoggerSpecs
-		Server  ServerSpecs
-		Version VersionSpecs `ignored:"true"`
+		Env       string `env:"ENV" default:"dev"`
+		DataDir   string `env:"DATA_DIR"`
+		Auth      AuthSpecs
+		DB        db.DBSpecs
+		Log       LoggerSpecs
+		Server    ServerSpecs
+		Retention RetentionSpecs
+		Forwarder ForwarderSpecs
+		Ingest    IngestSpecs
+		Secrets   SecretsSpecs
+		Metrics   MetricsSpecs
+		Tracing   TracingSpecs
+		Version   VersionSpecs `ignored:"true"`
 	}
 )
 
@@ -99,7 +213,7 @@ func prefixed(prefix, name string) string {
 }
 
 func (s *Specs) prepareViper() {
-	traverseStruct("", reflect.TypeOf(*s), reflect.ValueOf(s).Elem())
+	traverseStruct("", reflect.TypeOf(s).Elem(), reflect.ValueOf(s).Elem())
 }
 
 func (s *Specs) loadFromViper() error {
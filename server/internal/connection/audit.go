@@ -0,0 +1,77 @@
+package connection
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+type (
+	// Change is the value of an updated column before and after an update
+	Change struct {
+		Before any `json:"before"`
+		After  any `json:"after"`
+	}
+
+	// credentialsView describes credentials without their secrets
+	credentialsView struct {
+		Type          credentialsType `json:"type"`
+		NodeName      string          `json:"nodename,omitempty"`
+		HasPassword   bool            `json:"has_password,omitempty"`
+		HasPrivateKey bool            `json:"has_private_key,omitempty"`
+		Fingerprint   string          `json:"fingerprint,omitempty"`
+		SecretChanged bool            `json:"secret_changed,omitempty"`
+	}
+
+	// attributesView describes attributes with sinks reduced to the view of
+	// ToProto, values of custom headers aren't kept
+	attributesView struct {
+		rawAttributes
+		Sinks []json.RawMessage `json:"sinks,omitempty"`
+	}
+)
+
+// columnValues returns the value of every stored column for audit, credentials
+// and sinks are reduced to views without secrets. Must be called with c.lock held.
+func (c *Connection) columnValues() map[string]any {
+	attributes := attributesView{rawAttributes: c.getRawAttributes()}
+	for _, s := range c.sinks {
+		if data, err := protojson.Marshal(s.ToProto()); err == nil {
+			attributes.Sinks = append(attributes.Sinks, data)
+		}
+	}
+
+	return map[string]any{
+		models.ClientColumns.FriendlyName: c.friendlyName,
+		models.ClientColumns.Primary:      c.primaryNode,
+		models.ClientColumns.Secondaries:  append([]Node(nil), c.secondaryNodes...),
+		models.ClientColumns.Credentials:  c.credentials.view(),
+		models.ClientColumns.ClientName:   c.clientName,
+		models.ClientColumns.Owner:        c.owner,
+		models.ClientColumns.Attributes:   &attributes,
+	}
+}
+
+// changes returns the before and after values of the columns
+func changes(columns []string, before, after map[string]any) map[string]Change {
+	res := make(map[string]Change, len(columns))
+	for _, cl := range columns {
+		res[cl] = Change{Before: before[cl], After: after[cl]}
+	}
+	return res
+}
+
+func (cr Credentials) view() credentialsView {
+	v := credentialsView{
+		Type:          cr.Type,
+		NodeName:      cr.NodeName,
+		HasPassword:   cr.Password != "",
+		HasPrivateKey: cr.PrivateKey != "",
+	}
+	if info := certificateInfo(cr.Certificate); info != nil {
+		v.Fingerprint = info.FingerprintSha256
+	}
+	return v
+}
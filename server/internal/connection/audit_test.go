@@ -0,0 +1,36 @@
+package connection
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
+)
+
+func TestColumnValuesRedactSinks(t *testing.T) {
+	c := newTestConnection(t)
+	c.sinks = []forwarder.Config{{
+		Name: "hook",
+		Type: forwarder.TypeWebhook,
+		Webhook: &forwarder.WebhookConfig{
+			URL:     "https://example.com",
+			Headers: map[string]forwarder.Secret{"X-Api-Key": "k3y"},
+		},
+	}}
+
+	c.lock.Lock()
+	values := c.columnValues()
+	c.lock.Unlock()
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("k3y")) {
+		t.Errorf("header value is audited: %s", data)
+	}
+	if !bytes.Contains(data, []byte(`"X-Api-Key":""`)) {
+		t.Errorf("header name is not audited: %s", data)
+	}
+}
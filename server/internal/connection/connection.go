@@ -14,7 +14,10 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	pb "github.com/vkumov/go-pxgrider/pkg"
+	pxdb "github.com/vkumov/go-pxgrider/server/internal/db"
 	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
+	"github.com/vkumov/go-pxgrider/server/internal/ingest"
 	"github.com/vkumov/go-pxgrider/server/internal/logger"
 	"github.com/vkumov/go-pxgrider/server/internal/utils"
 )
@@ -45,15 +48,23 @@ type (
 		clientName     string
 		tlsCfg         tlsCfg
 		owner          string
+		retention      RetentionOverride
+		logLevel       LogLevel
+		sinks          []forwarder.Config
+		fwd            *forwarder.Forwarder
 		topics         map[ServiceName]map[TopicName]*Subscription
 		unsaved        map[string]struct{}
 
-		lock sync.Mutex
+		lock        sync.Mutex
+		supervisors sync.WaitGroup
 
-		log    *logger.Logger
-		pxCfg  atomic.Pointer[gopxgrid.PxGridConfig]
-		pxCnsm atomic.Pointer[gopxgrid.PxGridConsumer]
-		db     atomic.Pointer[sql.DB]
+		log     *logger.Logger
+		hub     *messageHub
+		pxCfg   atomic.Pointer[gopxgrid.PxGridConfig]
+		pxCnsm  atomic.Pointer[gopxgrid.PxGridConsumer]
+		db      atomic.Pointer[sql.DB]
+		dialect pxdb.Dialect
+		ingest  *ingest.Pipeline
 	}
 
 	ConnectionCreate struct {
@@ -82,6 +93,7 @@ type (
 		Owner          sql.Null[string]                      `json:"owner,omitempty"`
 		InsecureTLS    sql.Null[bool]                        `json:"insecureTLS,omitempty"`
 		CA             sql.Null[[]string]                    `json:"ca,omitempty"`
+		Retention      sql.Null[RetentionOverride]           `json:"retention,omitempty"`
 	}
 )
 
@@ -92,15 +104,18 @@ const (
 	DefaultControlPort = 8910
 )
 
-func New(db *sql.DB, id, owner string, log *zerolog.Logger, logWriter io.Writer) *Connection {
+func New(db *sql.DB, dialect pxdb.Dialect, pipeline *ingest.Pipeline, id, owner string, log *zerolog.Logger, logWriter io.Writer) *Connection {
 	// logger := log.With().Str("connection_id", id).Logger()
-	l := logger.NewCombined(id, log, db, logWriter, logger.ComponentFieldName, "pxgrid:consumer")
+	l := logger.NewCombined(id, pipeline, log, logWriter, logger.ComponentFieldName, "pxgrid:consumer")
 	c := &Connection{
 		id:      id,
 		state:   AccountStateUnknown,
 		owner:   owner,
 		log:     l,
+		hub:     newMessageHub(),
 		unsaved: make(map[string]struct{}),
+		dialect: dialect,
+		ingest:  pipeline,
 	}
 
 	c.db.Store(db)
@@ -109,8 +124,8 @@ func New(db *sql.DB, id, owner string, log *zerolog.Logger, logWriter io.Writer)
 	return c
 }
 
-func NewWithRequest(db *sql.DB, id, owner string, req ConnectionCreate, log *zerolog.Logger, logWriter io.Writer) (*Connection, error) {
-	c := New(db, id, owner, log, logWriter)
+func NewWithRequest(db *sql.DB, dialect pxdb.Dialect, pipeline *ingest.Pipeline, id, owner string, req ConnectionCreate, log *zerolog.Logger, logWriter io.Writer) (*Connection, error) {
+	c := New(db, dialect, pipeline, id, owner, log, logWriter)
 	c.friendlyName = req.FriendlyName
 	c.description = req.Description
 	c.dns = req.DNS
@@ -150,6 +165,23 @@ func NewWithRequest(db *sql.DB, id, owner string, req ConnectionCreate, log *zer
 }
 
 func (c *Connection) WithDBData(cl *models.Client) error {
+	if err := c.loadDBData(cl); err != nil {
+		return err
+	}
+	if err := c.log.SetLevels(c.logLevel.Stdout, c.logLevel.DB); err != nil {
+		return fmt.Errorf("failed to apply log level for connection %s: %w", c.id, err)
+	}
+
+	c.ensureAfterDBLoad()
+	if err := c.startSinks(); err != nil {
+		c.log.Error().Err(err).Msg("Failed to start connection sinks")
+	}
+
+	return c.RebuildPxGridConfig()
+}
+
+func (c *Connection) loadDBData(cl *models.Client) error {
+	c.owner = cl.Owner
 	c.friendlyName = cl.FriendlyName.String
 	c.clientName = cl.ClientName.String
 
@@ -172,9 +204,11 @@ func (c *Connection) WithDBData(cl *models.Client) error {
 		}
 	}
 	if cl.Credentials.Valid && !utils.IsEmptyJSON(cl.Credentials.JSON) {
-		if err := cl.Credentials.Unmarshal(&c.credentials); err != nil {
+		cr, err := openCredentials(c.id, cl.Credentials.JSON)
+		if err != nil {
 			return fmt.Errorf("failed to unmarshal credentials for connection %s: %w", c.id, err)
 		}
+		c.credentials = cr
 	}
 	if cl.Attributes.Valid && !utils.IsEmptyJSON(cl.Attributes.JSON) {
 		attr := new(rawAttributes)
@@ -193,6 +227,12 @@ func (c *Connection) WithDBData(cl *models.Client) error {
 		}
 
 		c.tlsCfg.InsecureSkipVerify = attr.Verify == "none"
+		c.retention = attr.Retention.toOverride()
+		c.sinks = attr.Sinks
+
+		if attr.LogLevel != nil {
+			c.logLevel = *attr.LogLevel
+		}
 	}
 	if cl.Topics.Valid && !utils.IsEmptyJSON(cl.Topics.JSON) {
 		if err := cl.Topics.Unmarshal(&c.topics); err != nil {
@@ -200,9 +240,7 @@ func (c *Connection) WithDBData(cl *models.Client) error {
 		}
 	}
 
-	c.ensureAfterDBLoad()
-
-	return c.RebuildPxGridConfig()
+	return nil
 }
 
 func (c *Connection) ensureAfterDBLoad() {
@@ -260,12 +298,15 @@ func (c *Connection) RebuildPxGridConfig() error {
 	return nil
 }
 
-func (c *Connection) Update(ctx context.Context, upd ConnectionUpdate) error {
+// Update stores the fields set in upd and returns the changes of the updated columns
+func (c *Connection) Update(ctx context.Context, upd ConnectionUpdate) (map[string]Change, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	shouldRollback := true
 	updatedColumns := make([]string, 0)
+	before := c.columnValues()
+	secretChanged := false
 
 	if upd.FriendlyName.Valid {
 		defer func(old string) {
@@ -303,7 +344,9 @@ func (c *Connection) Update(ctx context.Context, upd ConnectionUpdate) error {
 				c.credentials = old
 			}
 		}(c.credentials)
-		c.credentials = upd.Credentials.V
+		old := c.credentials
+		c.credentials = upd.Credentials.V.keepSecrets(old)
+		secretChanged = c.credentials.Password != old.Password || c.credentials.PrivateKey != old.PrivateKey
 		updatedColumns = append(updatedColumns, models.ClientColumns.Credentials)
 	}
 
@@ -361,6 +404,11 @@ func (c *Connection) Update(ctx context.Context, upd ConnectionUpdate) error {
 		updatedAttributes = true
 	}
 
+	if upd.Retention.Valid {
+		c.retention = upd.Retention.V
+		updatedAttributes = true
+	}
+
 	if updatedAttributes {
 		defer func(old rawAttributes) {
 			if shouldRollback {
@@ -371,17 +419,25 @@ func (c *Connection) Update(ctx context.Context, upd ConnectionUpdate) error {
 				c.tlsCfg.InsecureSkipVerify = old.Verify == "none"
 				c.tlsCfg.CA = make([]string, len(old.CA))
 				copy(c.tlsCfg.CA, old.CA)
+				c.retention = old.Retention.toOverride()
 			}
 		}(oldAttributes)
 		updatedColumns = append(updatedColumns, models.ClientColumns.Attributes)
 	}
 
-	err := c.store(ctx, updatedColumns)
-	if err == nil {
-		shouldRollback = false
+	if err := c.store(ctx, updatedColumns); err != nil {
+		return nil, err
+	}
+	shouldRollback = false
+
+	after := c.columnValues()
+	if secretChanged {
+		view := after[models.ClientColumns.Credentials].(credentialsView)
+		view.SecretChanged = true
+		after[models.ClientColumns.Credentials] = view
 	}
 
-	return err
+	return changes(updatedColumns, before, after), nil
 }
 
 func (c *Connection) store(ctx context.Context, columns []string) error {
@@ -399,9 +455,11 @@ func (c *Connection) store(ctx context.Context, columns []string) error {
 				return err
 			}
 		case models.ClientColumns.Credentials:
-			if err := dbRef.Credentials.Marshal(c.credentials); err != nil {
+			sealed, err := sealCredentials(c.id, c.credentials)
+			if err != nil {
 				return err
 			}
+			dbRef.Credentials = sealed
 		case models.ClientColumns.ClientName:
 			dbRef.ClientName = null.StringFrom(c.clientName)
 		case models.ClientColumns.Owner:
@@ -422,7 +480,7 @@ func (c *Connection) store(ctx context.Context, columns []string) error {
 		}
 	}
 
-	c.log.Debug().Interface("columns", columns).Interface("dbRef", dbRef).Str("id", c.id).
+	c.log.Debug().Interface("columns", columns).Interface("dbRef", logger.Redact(dbRef)).Str("id", c.id).
 		Msg("storing connection")
 
 	hasId := false
@@ -530,25 +588,7 @@ func (c *Connection) ToProto() *pb.Connection {
 		})
 	}
 
-	credentials := &pb.Credentials{}
-	switch c.credentials.Type {
-	case CredentialsTypeCertificate:
-		credentials.Type = pb.CredentialsType_CREDENTIALS_TYPE_CERTIFICATE
-		credentials.Kind = &pb.Credentials_Certificate{
-			Certificate: &pb.CredentialsCertificate{
-				Certificate:    c.credentials.Certificate,
-				PrivateKey:     c.credentials.PrivateKey,
-				CaCertificates: c.credentials.Chain,
-			},
-		}
-	case CredentialsTypePassword:
-		credentials.Type = pb.CredentialsType_CREDENTIALS_TYPE_PASSWORD
-		credentials.Kind = &pb.Credentials_Password{
-			Password: &pb.CredentialsPassword{
-				Password: c.credentials.Password,
-			},
-		}
-	}
+	credentials := c.credentials.toProto()
 
 	var dnsDetails *pb.DNSDetails
 	if prsd, err := gopxgrid.ParseDNSHost(c.dns); err == nil {
@@ -597,6 +637,9 @@ func (c *Connection) ToProto() *pb.Connection {
 		ClientName:   c.clientName,
 		Owner:        &pb.User{Uid: c.owner},
 		DnsDetails:   dnsDetails,
+		Retention:    c.retention.ToProto(),
+		Sinks:        forwarder.ConfigsToProto(c.sinks),
+		LogLevel:     c.logLevel.ToProto(),
 	}
 }
 
@@ -606,7 +649,7 @@ func (c *Connection) CleanupSubscriptions() {
 
 	for svc, topics := range c.topics {
 		for topic, t := range topics {
-			_ = t.s.Unsubscribe()
+			_ = t.close()
 			delete(topics, topic)
 		}
 		delete(c.topics, svc)
@@ -636,7 +679,25 @@ func (c *Connection) GetServiceByName(name string) (gopxgrid.PxGridService, erro
 	return c.getServiceByName(name)
 }
 
+// Stop closes the subscriptions and waits until their messages and the queued
+// logs are stored
 func (c *Connection) Stop() {
 	c.CleanupSubscriptions()
+	c.supervisors.Wait()
+	c.stopSinks()
+	c.hub.closeAll()
 	c.log.Stop()
 }
+
+// Flush stores the fields changed since the last store, if any
+func (c *Connection) Flush(ctx context.Context) error {
+	c.lock.Lock()
+	pending := len(c.unsaved)
+	c.lock.Unlock()
+
+	if pending == 0 {
+		return nil
+	}
+
+	return c.storeUnsaved(ctx)
+}
@@ -1,5 +1,20 @@
 package connection
 
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+
+	"github.com/volatiletech/null/v8"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+
+	"github.com/vkumov/go-pxgrider/server/internal/secrets"
+)
+
 type (
 	credentialsType string
 
@@ -17,3 +32,100 @@ const (
 	CredentialsTypePassword    credentialsType = "password"
 	CredentialsTypeCertificate credentialsType = "certificate"
 )
+
+// sealCredentials encrypts the credentials for the clients.credentials column,
+// the connection id is authenticated so rows can't be swapped
+func sealCredentials(id string, cr Credentials) (null.JSON, error) {
+	data, err := json.Marshal(cr)
+	if err != nil {
+		return null.JSON{}, err
+	}
+
+	sealed, err := secrets.Seal(data, []byte(id))
+	if err != nil {
+		return null.JSON{}, err
+	}
+
+	return null.JSONFrom(sealed), nil
+}
+
+func openCredentials(id string, data []byte) (Credentials, error) {
+	var cr Credentials
+
+	plain, err := secrets.Open(data, []byte(id))
+	if err != nil {
+		return cr, err
+	}
+	err = json.Unmarshal(plain, &cr)
+
+	return cr, err
+}
+
+// keepSecrets fills secrets left empty in an update of the same type from the
+// stored credentials, as the API never returns them
+func (cr Credentials) keepSecrets(stored Credentials) Credentials {
+	if cr.Type != stored.Type {
+		return cr
+	}
+
+	switch cr.Type {
+	case CredentialsTypePassword:
+		if cr.Password == "" {
+			cr.Password = stored.Password
+		}
+	case CredentialsTypeCertificate:
+		if cr.PrivateKey == "" {
+			cr.PrivateKey = stored.PrivateKey
+		}
+	}
+
+	return cr
+}
+
+// toProto returns the credentials without secrets
+func (cr Credentials) toProto() *pb.Credentials {
+	p := &pb.Credentials{NodeName: cr.NodeName}
+	switch cr.Type {
+	case CredentialsTypeCertificate:
+		p.Type = pb.CredentialsType_CREDENTIALS_TYPE_CERTIFICATE
+		p.Kind = &pb.Credentials_Certificate{
+			Certificate: &pb.CredentialsCertificate{
+				Certificate:    cr.Certificate,
+				CaCertificates: cr.Chain,
+				HasPrivateKey:  cr.PrivateKey != "",
+				Info:           certificateInfo(cr.Certificate),
+			},
+		}
+	case CredentialsTypePassword:
+		p.Type = pb.CredentialsType_CREDENTIALS_TYPE_PASSWORD
+		p.Kind = &pb.Credentials_Password{
+			Password: &pb.CredentialsPassword{
+				HasPassword: cr.Password != "",
+			},
+		}
+	}
+
+	return p
+}
+
+// certificateInfo describes the first certificate of the PEM, nil if there is none
+func certificateInfo(certPEM string) *pb.CertificateInfo {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+
+	sum := sha256.Sum256(cert.Raw)
+	return &pb.CertificateInfo{
+		Subject:           cert.Subject.String(),
+		Issuer:            cert.Issuer.String(),
+		SerialNumber:      cert.SerialNumber.Text(16),
+		NotBefore:         timestamppb.New(cert.NotBefore),
+		NotAfter:          timestamppb.New(cert.NotAfter),
+		FingerprintSha256: hex.EncodeToString(sum[:]),
+	}
+}
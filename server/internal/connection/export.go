@@ -0,0 +1,352 @@
+package connection
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+type (
+	ExportFormat int
+
+	messageEncoder interface {
+		encode(batch []*models.Message) error
+		close() error
+	}
+
+	ndjsonEncoder struct {
+		enc *json.Encoder
+	}
+
+	ndjsonRow struct {
+		ID        int64           `json:"id"`
+		Client    string          `json:"client"`
+		Service   *string         `json:"service,omitempty"`
+		Topic     string          `json:"topic"`
+		Timestamp *time.Time      `json:"timestamp,omitempty"`
+		Viewed    *bool           `json:"viewed,omitempty"`
+		Message   json.RawMessage `json:"message,omitempty"`
+	}
+
+	csvEncoder struct {
+		w       *csv.Writer
+		columns []string
+		header  bool
+	}
+
+	parquetEncoder struct {
+		w    *parquet.GenericWriter[parquetRow]
+		rows []parquetRow
+	}
+
+	parquetRow struct {
+		ID        int64      `parquet:"id"`
+		Client    string     `parquet:"client"`
+		Service   *string    `parquet:"service"`
+		Topic     string     `parquet:"topic"`
+		Timestamp *time.Time `parquet:"timestamp"`
+		Viewed    *bool      `parquet:"viewed"`
+		Message   string     `parquet:"message,optional,json"`
+	}
+)
+
+const (
+	ExportNDJSON ExportFormat = iota
+	ExportCSV
+	ExportParquet
+)
+
+const parquetRowGroupSize = 10000
+
+var csvBaseColumns = []string{"id", "client", "service", "topic", "timestamp", "viewed"}
+
+// ExportMessages writes messages matching the filter to w, oldest first, reading them
+// from the database in batches. CSV columns are the flattened message fields, if none
+// are given the messages are read twice, first to collect the fields of all of them.
+// Returns the number of exported messages.
+func (c *Connection) ExportMessages(ctx context.Context, f *MessagesFilter, format ExportFormat,
+	columns []string, w io.Writer) (int64, error) {
+	// upToID bounds the export to the messages seen while collecting columns
+	var upToID int64
+
+	var enc messageEncoder
+	switch format {
+	case ExportNDJSON:
+		enc = &ndjsonEncoder{enc: json.NewEncoder(w)}
+	case ExportCSV:
+		if columns == nil {
+			var err error
+			if columns, upToID, err = c.collectColumns(ctx, f); err != nil {
+				return 0, err
+			}
+		}
+		enc = &csvEncoder{w: csv.NewWriter(w), columns: columns}
+	case ExportParquet:
+		enc = &parquetEncoder{w: parquet.NewGenericWriter[parquetRow](w,
+			parquet.Compression(&parquet.Snappy),
+			parquet.MaxRowsPerRowGroup(parquetRowGroupSize),
+		)}
+	default:
+		return 0, errors.New("unknown export format")
+	}
+
+	var total int64
+	err := c.eachFilteredBatch(ctx, f, upToID, func(batch []*models.Message) error {
+		total += int64(len(batch))
+		return enc.encode(batch)
+	})
+	if err != nil {
+		return total, err
+	}
+
+	if err := enc.close(); err != nil {
+		return total, err
+	}
+	c.log.Debug().Int64("messages", total).Msg("Messages exported")
+
+	return total, nil
+}
+
+// collectColumns returns the sorted flattened fields of the messages matching
+// the filter and the ID of the last of them
+func (c *Connection) collectColumns(ctx context.Context, f *MessagesFilter) ([]string, int64, error) {
+	var (
+		lastID int64
+		seen   = make(map[string]struct{})
+	)
+	err := c.eachFilteredBatch(ctx, f, 0, func(batch []*models.Message) error {
+		for _, m := range batch {
+			for k := range flattenMessage(m) {
+				seen[k] = struct{}{}
+			}
+		}
+		lastID = batch[len(batch)-1].ID
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	columns := make([]string, 0, len(seen))
+	for k := range seen {
+		columns = append(columns, k)
+	}
+	slices.Sort(columns)
+
+	// nothing matched, the export must not pick up later messages
+	if lastID == 0 {
+		lastID = -1
+	}
+
+	return columns, lastID, nil
+}
+
+// eachFilteredBatch walks messages matching the filter in ascending ID order, batch by batch,
+// up to upToID if positive. A negative upToID matches nothing.
+func (c *Connection) eachFilteredBatch(ctx context.Context, f *MessagesFilter, upToID int64,
+	fn func(batch []*models.Message) error) error {
+	if upToID < 0 {
+		return nil
+	}
+
+	var afterID int64
+	for {
+		q := []qm.QueryMod{
+			models.MessageWhere.Client.EQ(c.id),
+			models.MessageWhere.ID.GT(afterID),
+			qm.OrderBy(models.MessageColumns.ID + " ASC"),
+			qm.Limit(backlogBatchSize),
+		}
+		if upToID > 0 {
+			q = append(q, models.MessageWhere.ID.LTE(upToID))
+		}
+		q = append(q, f.queryMods(c.dialect)...)
+
+		batch, err := models.Messages(q...).All(ctx, c.db.Load())
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		afterID = batch[len(batch)-1].ID
+
+		matched := batch
+		if f.inMemory(c.dialect) {
+			matched = make(models.MessageSlice, 0, len(batch))
+			for _, m := range batch {
+				if ok, err := f.match(c.dialect, m); err == nil && ok {
+					matched = append(matched, m)
+				}
+			}
+		}
+
+		if len(matched) > 0 {
+			if err := fn(matched); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < backlogBatchSize {
+			return nil
+		}
+	}
+}
+
+func (e *ndjsonEncoder) encode(batch []*models.Message) error {
+	for _, m := range batch {
+		row := ndjsonRow{ID: m.ID, Client: m.Client, Topic: m.Topic}
+		if m.Service.Valid {
+			row.Service = &m.Service.String
+		}
+		if m.Timestamp.Valid {
+			row.Timestamp = &m.Timestamp.Time
+		}
+		if m.Viewed.Valid {
+			row.Viewed = &m.Viewed.Bool
+		}
+		if m.Message.Valid {
+			row.Message = json.RawMessage(m.Message.JSON)
+		}
+
+		if err := e.enc.Encode(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *ndjsonEncoder) close() error { return nil }
+
+func (e *csvEncoder) encode(batch []*models.Message) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	record := make([]string, len(csvBaseColumns)+len(e.columns))
+	for _, m := range batch {
+		flat := flattenMessage(m)
+		record[0] = strconv.FormatInt(m.ID, 10)
+		record[1] = m.Client
+		record[2] = m.Service.String
+		record[3] = m.Topic
+		record[4], record[5] = "", ""
+		if m.Timestamp.Valid {
+			record[4] = m.Timestamp.Time.Format(time.RFC3339Nano)
+		}
+		if m.Viewed.Valid {
+			record[5] = strconv.FormatBool(m.Viewed.Bool)
+		}
+		for j, col := range e.columns {
+			record[len(csvBaseColumns)+j] = flat[col]
+		}
+
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvEncoder) writeHeader() error {
+	if e.header {
+		return nil
+	}
+	e.header = true
+
+	return e.w.Write(append(slices.Clone(csvBaseColumns), e.columns...))
+}
+
+func (e *csvEncoder) close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// flattenMessage returns the flattened fields of the message
+func flattenMessage(m *models.Message) map[string]string {
+	flat := make(map[string]string)
+	if m.Message.Valid {
+		var v any
+		if err := json.Unmarshal(m.Message.JSON, &v); err == nil {
+			flatten("", v, flat)
+		}
+	}
+
+	return flat
+}
+
+// flatten stores the leaves of nested objects under dot separated keys,
+// arrays are kept as JSON
+func flatten(prefix string, v any, out map[string]string) {
+	if obj, ok := v.(map[string]any); ok {
+		for k, child := range obj {
+			if prefix != "" {
+				k = prefix + "." + k
+			}
+			flatten(k, child, out)
+		}
+		return
+	}
+
+	if prefix == "" {
+		prefix = "message"
+	}
+
+	switch v := v.(type) {
+	case nil:
+		out[prefix] = ""
+	case string:
+		out[prefix] = v
+	case float64:
+		out[prefix] = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		out[prefix] = strconv.FormatBool(v)
+	default:
+		raw, _ := json.Marshal(v)
+		out[prefix] = string(raw)
+	}
+}
+
+func (e *parquetEncoder) encode(batch []*models.Message) error {
+	e.rows = e.rows[:0]
+	for _, m := range batch {
+		row := parquetRow{ID: m.ID, Client: m.Client, Topic: m.Topic}
+		if m.Service.Valid {
+			row.Service = &m.Service.String
+		}
+		if m.Timestamp.Valid {
+			row.Timestamp = &m.Timestamp.Time
+		}
+		if m.Viewed.Valid {
+			row.Viewed = &m.Viewed.Bool
+		}
+		if m.Message.Valid {
+			row.Message = string(m.Message.JSON)
+		}
+		e.rows = append(e.rows, row)
+	}
+
+	_, err := e.w.Write(e.rows)
+	return err
+}
+
+func (e *parquetEncoder) close() error {
+	return e.w.Close()
+}
@@ -0,0 +1,154 @@
+package connection
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+func testMessages() []*models.Message {
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	return []*models.Message{
+		{
+			ID:        1,
+			Client:    "c1",
+			Service:   null.StringFrom("com.cisco.ise.session"),
+			Topic:     "sessionTopic",
+			Timestamp: null.TimeFrom(ts),
+			Viewed:    null.BoolFrom(true),
+			Message:   null.JSONFrom([]byte(`{"sessions":[{"state":"STARTED"}],"user":{"name":"alice"}}`)),
+		},
+		{
+			ID:     2,
+			Client: "c1",
+			Topic:  "sessionTopic",
+		},
+	}
+}
+
+func TestParquetEncoderRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	enc := &parquetEncoder{w: parquet.NewGenericWriter[parquetRow](&buf, parquet.Compression(&parquet.Snappy))}
+
+	msgs := testMessages()
+	if err := enc.encode(msgs[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.encode(msgs[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := parquet.Read[parquetRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+
+	first := rows[0]
+	if first.ID != 1 || first.Client != "c1" || first.Topic != "sessionTopic" ||
+		first.Service == nil || *first.Service != "com.cisco.ise.session" {
+		t.Errorf("unexpected first row %+v", first)
+	}
+	if first.Timestamp == nil || !first.Timestamp.Equal(msgs[0].Timestamp.Time) {
+		t.Errorf("timestamp = %v, want %v", first.Timestamp, msgs[0].Timestamp.Time)
+	}
+	if first.Viewed == nil || !*first.Viewed {
+		t.Errorf("viewed = %v, want true", first.Viewed)
+	}
+	if first.Message != string(msgs[0].Message.JSON) {
+		t.Errorf("message = %s, want %s", first.Message, msgs[0].Message.JSON)
+	}
+
+	second := rows[1]
+	if second.ID != 2 || second.Service != nil || second.Timestamp != nil || second.Viewed != nil || second.Message != "" {
+		t.Errorf("unexpected second row %+v", second)
+	}
+}
+
+func TestCSVEncoderColumns(t *testing.T) {
+	var buf bytes.Buffer
+	enc := &csvEncoder{w: csv.NewWriter(&buf), columns: []string{"sessions", "user.name", "user.role"}}
+
+	msgs := testMessages()
+	msgs[1].Message = null.JSONFrom([]byte(`{"user":{"role":"admin"}}`))
+	if err := enc.encode(msgs[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.encode(msgs[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{
+		{"id", "client", "service", "topic", "timestamp", "viewed", "sessions", "user.name", "user.role"},
+		{"1", "c1", "com.cisco.ise.session", "sessionTopic", "2024-05-01T12:30:00Z", "true", `[{"state":"STARTED"}]`, "alice", ""},
+		{"2", "c1", "", "sessionTopic", "", "", "", "", "admin"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d", len(records), len(want))
+	}
+	for i := range want {
+		for j := range want[i] {
+			if records[i][j] != want[i][j] {
+				t.Errorf("record %d column %d = %q, want %q", i, j, records[i][j], want[i][j])
+			}
+		}
+	}
+}
+
+func TestNDJSONEncoderService(t *testing.T) {
+	var buf bytes.Buffer
+	enc := &ndjsonEncoder{enc: json.NewEncoder(&buf)}
+	if err := enc.encode(testMessages()); err != nil {
+		t.Fatal(err)
+	}
+
+	dec := json.NewDecoder(&buf)
+	var services []any
+	for dec.More() {
+		var row map[string]any
+		if err := dec.Decode(&row); err != nil {
+			t.Fatal(err)
+		}
+		services = append(services, row["service"])
+	}
+	if len(services) != 2 || services[0] != "com.cisco.ise.session" || services[1] != nil {
+		t.Errorf("services = %v, want [com.cisco.ise.session <nil>]", services)
+	}
+}
+
+func TestFlattenMessage(t *testing.T) {
+	flat := flattenMessage(testMessages()[0])
+
+	want := map[string]string{
+		"sessions":  `[{"state":"STARTED"}]`,
+		"user.name": "alice",
+	}
+	if len(flat) != len(want) {
+		t.Fatalf("got %v, want %v", flat, want)
+	}
+	for k, v := range want {
+		if flat[k] != v {
+			t.Errorf("%s = %q, want %q", k, flat[k], v)
+		}
+	}
+}
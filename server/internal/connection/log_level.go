@@ -0,0 +1,57 @@
+package connection
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+// LogLevel overrides the server log level for the logs of a connection printed
+// to stdout and stored in the database, empty levels aren't overridden
+type LogLevel struct {
+	Stdout string `json:"stdout,omitempty"`
+	DB     string `json:"db,omitempty"`
+}
+
+func (l LogLevel) IsZero() bool {
+	return l.Stdout == "" && l.DB == ""
+}
+
+func (l LogLevel) ToProto() *pb.LogLevel {
+	return &pb.LogLevel{Stdout: l.Stdout, Db: l.DB}
+}
+
+func (l LogLevel) toRaw() *LogLevel {
+	if l.IsZero() {
+		return nil
+	}
+	return &l
+}
+
+// SetLogLevel applies and stores the levels of the logs of the connection
+func (c *Connection) SetLogLevel(ctx context.Context, level LogLevel) (LogLevel, error) {
+	level.Stdout = strings.ToLower(strings.TrimSpace(level.Stdout))
+	level.DB = strings.ToLower(strings.TrimSpace(level.DB))
+
+	c.lock.Lock()
+	if err := c.log.SetLevels(level.Stdout, level.DB); err != nil {
+		c.lock.Unlock()
+		return LogLevel{}, err
+	}
+	c.logLevel = level
+	c.unsaved[models.ClientColumns.Attributes] = struct{}{}
+	c.lock.Unlock()
+
+	c.log.Info().Str("stdout", level.Stdout).Str("db", level.DB).Msg("Log level updated")
+
+	return level, c.storeUnsaved(ctx)
+}
+
+func (c *Connection) LogLevel() LogLevel {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.logLevel
+}
@@ -12,29 +12,50 @@ import (
 
 type LogsSlice models.LogSlice
 
-func (c *Connection) GetLogs(ctx context.Context, limit, offset int64) (LogsSlice, error) {
+// GetLogs returns a page of logs, newest first, along with the cursor of the next page
+// if there may be one
+func (c *Connection) GetLogs(ctx context.Context, p Page) (LogsSlice, string, error) {
+	beforeID, err := p.beforeID()
+	if err != nil {
+		return nil, "", err
+	}
+
 	q := []qm.QueryMod{
 		models.LogWhere.Client.EQ(c.id),
 		qm.OrderBy(models.LogColumns.ID + " DESC"),
 	}
+	if beforeID > 0 {
+		q = append(q, models.LogWhere.ID.LT(beforeID))
+	}
 
-	if limit > 0 {
-		q = append(q, qm.Limit(int(limit)))
-		q = append(q, qm.Offset(int(offset)))
+	if p.Limit > 0 {
+		q = append(q, qm.Limit(int(p.Limit)))
+		q = append(q, qm.Offset(int(p.offset())))
 	}
 
 	raw, err := models.Logs(q...).All(ctx, c.db.Load())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return LogsSlice(raw), nil
+	var next string
+	if p.Limit > 0 && int64(len(raw)) == p.Limit {
+		last := raw[len(raw)-1]
+		next = encodeCursor(last.ID)
+	}
+
+	return LogsSlice(raw), next, nil
 }
 
 func (c *Connection) GetLogsCount(ctx context.Context) (int64, error) {
 	return models.Logs(models.LogWhere.Client.EQ(c.id)).Count(ctx, c.db.Load())
 }
 
+// EstimateLogsCount returns the planner estimate of the number of logs
+func (c *Connection) EstimateLogsCount(ctx context.Context) (int64, error) {
+	return c.estimateRows(ctx, models.Logs(models.LogWhere.Client.EQ(c.id)).Query)
+}
+
 func (m LogsSlice) ToProto() []*pb.ConnectionLog {
 	var res []*pb.ConnectionLog
 
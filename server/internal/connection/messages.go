@@ -12,27 +12,144 @@ import (
 
 type MessageSlice models.MessageSlice
 
-func (c *Connection) GetMessages(ctx context.Context, limit, offset int64) (MessageSlice, error) {
-	q := []qm.QueryMod{
-		models.MessageWhere.Client.EQ(c.id),
-		qm.OrderBy(models.MessageColumns.ID + " DESC"),
+// maxFilterScan bounds the messages scanned by a request with a filter
+// evaluated on each message, such as a JMESPath expression
+const maxFilterScan = 20_000
+
+// GetMessages returns a page of stored messages matching the filter, newest first,
+// along with the cursor of the next page if there may be one
+func (c *Connection) GetMessages(ctx context.Context, f *MessagesFilter, p Page) (MessageSlice, string, error) {
+	beforeID, err := p.beforeID()
+	if err != nil {
+		return nil, "", err
 	}
 
-	if limit > 0 {
-		q = append(q, qm.Limit(int(limit)))
-		q = append(q, qm.Offset(int(offset)))
-	}
+	var (
+		res         MessageSlice
+		lastScanned int64
+	)
+	if f.inMemory(c.dialect) {
+		res, lastScanned, err = c.scanMessages(ctx, f, beforeID, p.Limit, p.offset())
+	} else {
+		q := []qm.QueryMod{
+			models.MessageWhere.Client.EQ(c.id),
+			qm.OrderBy(models.MessageColumns.ID + " DESC"),
+		}
+		if beforeID > 0 {
+			q = append(q, models.MessageWhere.ID.LT(beforeID))
+		}
+		q = append(q, f.queryMods(c.dialect)...)
+
+		if p.Limit > 0 {
+			q = append(q, qm.Limit(int(p.Limit)))
+			q = append(q, qm.Offset(int(p.offset())))
+		}
 
-	raw, err := models.Messages(q...).All(ctx, c.db.Load())
+		var raw models.MessageSlice
+		raw, err = models.Messages(q...).All(ctx, c.db.Load())
+		res = MessageSlice(raw)
+	}
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return MessageSlice(raw), nil
+	var next string
+	switch {
+	case p.Limit > 0 && int64(len(res)) == p.Limit:
+		last := res[len(res)-1]
+		next = encodeCursor(last.ID)
+	case lastScanned > 0:
+		// the scan stopped before filling the page, the next one resumes after
+		// the last scanned message
+		next = encodeCursor(lastScanned)
+	}
+
+	return res, next, nil
+}
+
+// GetMessagesCount returns the number of stored messages matching the filter.
+// If the filter is evaluated on each message only the newest maxFilterScan
+// messages are scanned, the count is then approximate: the matches among them.
+func (c *Connection) GetMessagesCount(ctx context.Context, f *MessagesFilter) (total int64, approximate bool, err error) {
+	if f.inMemory(c.dialect) {
+		lastScanned, err := c.eachFilteredMessage(ctx, f, 0, func(*models.Message) bool {
+			total++
+			return true
+		})
+		return total, lastScanned > 0, err
+	}
+
+	q := append([]qm.QueryMod{models.MessageWhere.Client.EQ(c.id)}, f.queryMods(c.dialect)...)
+	total, err = models.Messages(q...).Count(ctx, c.db.Load())
+	return total, false, err
+}
+
+// EstimateMessagesCount returns the planner estimate of the number of messages matching
+// the filter, the JMESPath expression is not taken into account
+func (c *Connection) EstimateMessagesCount(ctx context.Context, f *MessagesFilter) (int64, error) {
+	q := append([]qm.QueryMod{models.MessageWhere.Client.EQ(c.id)}, f.queryMods(c.dialect)...)
+	return c.estimateRows(ctx, models.Messages(q...).Query)
+}
+
+func (c *Connection) scanMessages(ctx context.Context, f *MessagesFilter, beforeID, limit, offset int64) (MessageSlice, int64, error) {
+	var res MessageSlice
+	lastScanned, err := c.eachFilteredMessage(ctx, f, beforeID, func(m *models.Message) bool {
+		if offset > 0 {
+			offset--
+			return true
+		}
+		res = append(res, m)
+		return limit <= 0 || int64(len(res)) < limit
+	})
+
+	return res, lastScanned, err
 }
 
-func (c *Connection) GetMessagesCount(ctx context.Context) (int64, error) {
-	return models.Messages(models.MessageWhere.Client.EQ(c.id)).Count(ctx, c.db.Load())
+// eachFilteredMessage walks messages matching the filter with ID below beforeID (if set),
+// newest first, until fn returns false. It scans at most maxFilterScan messages
+// and returns the ID of the last scanned one if it stopped at that limit, 0 otherwise.
+func (c *Connection) eachFilteredMessage(ctx context.Context, f *MessagesFilter, beforeID int64,
+	fn func(m *models.Message) bool) (int64, error) {
+	scanned := 0
+	for {
+		if scanned >= maxFilterScan {
+			return beforeID, nil
+		}
+
+		limit := min(backlogBatchSize, maxFilterScan-scanned)
+		q := []qm.QueryMod{
+			models.MessageWhere.Client.EQ(c.id),
+			qm.OrderBy(models.MessageColumns.ID + " DESC"),
+			qm.Limit(limit),
+		}
+		if beforeID > 0 {
+			q = append(q, models.MessageWhere.ID.LT(beforeID))
+		}
+		q = append(q, f.queryMods(c.dialect)...)
+
+		batch, err := models.Messages(q...).All(ctx, c.db.Load())
+		if err != nil {
+			return 0, err
+		}
+
+		for _, m := range batch {
+			beforeID = m.ID
+			scanned++
+
+			ok, err := f.match(c.dialect, m)
+			if err != nil {
+				c.log.Debug().Err(err).Int64("id", m.ID).Msg("Failed to evaluate messages filter")
+				continue
+			}
+			if ok && !fn(m) {
+				return 0, nil
+			}
+		}
+
+		if len(batch) < limit {
+			return 0, nil
+		}
+	}
 }
 
 func (m MessageSlice) ToProto() []*pb.ConnectionMessage {
@@ -0,0 +1,210 @@
+package connection
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+	pxdb "github.com/vkumov/go-pxgrider/server/internal/db"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+type (
+	MessageFieldFilter struct {
+		Path  []string
+		Value string
+	}
+
+	MessagesFilter struct {
+		Topics   []string
+		From     time.Time
+		To       time.Time
+		Viewed   *bool
+		Fields   []MessageFieldFilter
+		Contains string
+		JMESPath string
+
+		jp       *jmespath.JMESPath
+		contains any
+	}
+)
+
+// MessagesFilterFromProto validates the filter and compiles its JMESPath expression
+func MessagesFilterFromProto(p *pb.MessagesFilter) (*MessagesFilter, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	f := &MessagesFilter{
+		Topics:   p.GetTopics(),
+		Viewed:   p.Viewed,
+		Contains: p.GetContains(),
+		JMESPath: p.GetJmespath(),
+	}
+	if p.GetFrom() != nil {
+		f.From = p.GetFrom().AsTime()
+	}
+	if p.GetTo() != nil {
+		f.To = p.GetTo().AsTime()
+	}
+
+	for _, field := range p.GetFields() {
+		if field.GetPath() == "" {
+			return nil, fmt.Errorf("empty field path")
+		}
+		f.Fields = append(f.Fields, MessageFieldFilter{
+			Path:  strings.Split(field.GetPath(), "."),
+			Value: field.GetValue(),
+		})
+	}
+
+	if f.Contains != "" {
+		if err := json.Unmarshal([]byte(f.Contains), &f.contains); err != nil {
+			return nil, fmt.Errorf("contains filter is not a valid JSON")
+		}
+	}
+
+	if f.JMESPath != "" {
+		jp, err := jmespath.Compile(f.JMESPath)
+		if err != nil {
+			return nil, fmt.Errorf("bad jmespath expression: %w", err)
+		}
+		f.jp = jp
+	}
+
+	return f, nil
+}
+
+// queryMods returns the part of the filter evaluated by the database
+func (f *MessagesFilter) queryMods(d pxdb.Dialect) []qm.QueryMod {
+	if f == nil {
+		return nil
+	}
+
+	var q []qm.QueryMod
+	if len(f.Topics) > 0 {
+		q = append(q, models.MessageWhere.Topic.IN(f.Topics))
+	}
+	if !f.From.IsZero() {
+		q = append(q, models.MessageWhere.Timestamp.GTE(null.TimeFrom(f.From)))
+	}
+	if !f.To.IsZero() {
+		q = append(q, models.MessageWhere.Timestamp.LTE(null.TimeFrom(f.To)))
+	}
+	if f.Viewed != nil {
+		q = append(q, models.MessageWhere.Viewed.EQ(null.BoolFrom(*f.Viewed)))
+	}
+	for _, field := range f.Fields {
+		q = append(q, d.JSONFieldEquals(models.MessageColumns.Message, field.Path, field.Value))
+	}
+	if f.Contains != "" {
+		if mod, ok := d.JSONContains(models.MessageColumns.Message, f.Contains); ok {
+			q = append(q, mod)
+		}
+	}
+
+	return q
+}
+
+// inMemory reports whether part of the filter has to be evaluated on each message
+func (f *MessagesFilter) inMemory(d pxdb.Dialect) bool {
+	return f != nil && (f.jp != nil || f.containsInMemory(d))
+}
+
+// containsInMemory reports whether the contains filter is evaluated on each
+// message, as the database has no JSON containment operator
+func (f *MessagesFilter) containsInMemory(d pxdb.Dialect) bool {
+	if f.Contains == "" {
+		return false
+	}
+	_, ok := d.JSONContains(models.MessageColumns.Message, f.Contains)
+	return !ok
+}
+
+func (f *MessagesFilter) match(d pxdb.Dialect, m *models.Message) (bool, error) {
+	if !f.inMemory(d) {
+		return true, nil
+	}
+	if !m.Message.Valid {
+		return false, nil
+	}
+
+	var data any
+	if err := json.Unmarshal(m.Message.JSON, &data); err != nil {
+		return false, err
+	}
+
+	if f.containsInMemory(d) && !jsonContains(data, f.contains) {
+		return false, nil
+	}
+	if f.jp == nil {
+		return true, nil
+	}
+
+	res, err := f.jp.Search(data)
+	if err != nil {
+		return false, err
+	}
+
+	return isTruthy(res), nil
+}
+
+// isTruthy follows JMESPath rules: false, null, empty strings, arrays and objects are falsy
+func isTruthy(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+// jsonContains follows the jsonb @> operator: objects contain a subset of their
+// pairs, arrays contain elements contained by any of their elements
+func jsonContains(doc, sub any) bool {
+	switch sub := sub.(type) {
+	case map[string]any:
+		d, ok := doc.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range sub {
+			dv, ok := d[k]
+			if !ok || !jsonContains(dv, v) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		d, ok := doc.([]any)
+		if !ok {
+			return false
+		}
+		for _, v := range sub {
+			if !slices.ContainsFunc(d, func(dv any) bool { return jsonContains(dv, v) }) {
+				return false
+			}
+		}
+		return true
+	default:
+		if d, ok := doc.([]any); ok {
+			return slices.Contains(d, any(sub))
+		}
+		return doc == sub
+	}
+}
@@ -0,0 +1,75 @@
+package connection
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+type (
+	// Page selects a page either by offset or, if Cursor is set, by keyset
+	Page struct {
+		Limit  int64
+		Offset int64
+		Cursor string
+	}
+
+	// cursor points at the last row of the previous page, rows are ordered by ID
+	// descending, which follows the insert order
+	cursor struct {
+		ID int64 `json:"id"`
+	}
+)
+
+var ErrBadCursor = errors.New("bad cursor")
+
+func encodeCursor(id int64) string {
+	raw, _ := json.Marshal(cursor{ID: id})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrBadCursor
+	}
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID <= 0 {
+		return c, ErrBadCursor
+	}
+
+	return c, nil
+}
+
+// beforeID returns the ID the page starts below, 0 if it starts at the newest row
+func (p Page) beforeID() (int64, error) {
+	if p.Cursor == "" {
+		return 0, nil
+	}
+
+	c, err := decodeCursor(p.Cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.ID, nil
+}
+
+// offset is ignored once paging by cursor
+func (p Page) offset() int64 {
+	if p.Cursor != "" {
+		return 0
+	}
+	return p.Offset
+}
+
+// estimateRows returns the number of rows the query yields, estimated by the
+// database if it has a cheap estimate
+func (c *Connection) estimateRows(ctx context.Context, q *queries.Query) (int64, error) {
+	query, args := queries.BuildQuery(q)
+	return c.dialect.EstimateRows(ctx, c.db.Load(), query, args...)
+}
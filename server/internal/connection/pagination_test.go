@@ -0,0 +1,156 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	pxdb "github.com/vkumov/go-pxgrider/server/internal/db"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+// newSQLiteConnection returns a test connection backed by a migrated SQLite
+// database holding its client row
+func newSQLiteConnection(t *testing.T) *Connection {
+	t.Helper()
+
+	ctx := context.Background()
+	log := zerolog.Nop()
+	db, d, err := pxdb.NewSQL(ctx, pxdb.DBSpecs{
+		Driver:      pxdb.DriverSQLite,
+		Path:        filepath.Join(t.TempDir(), "test.db"),
+		ConnRetry:   1,
+		ConnTimeout: time.Second,
+	}, &log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := pxdb.MigrateUp(ctx, db, d); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO clients (id, owner) VALUES ('c1', 'alice')`); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newTestConnection(t)
+	c.db.Store(db)
+	c.dialect = d
+
+	return c
+}
+
+func TestGetMessagesPagesOnSQLite(t *testing.T) {
+	ctx := context.Background()
+	c := newSQLiteConnection(t)
+
+	// ten messages alternating between two topics, every third one viewed
+	for i := range 10 {
+		m := &models.Message{
+			Client:  "c1",
+			Topic:   fmt.Sprintf("topic-%d", i%2),
+			Message: null.JSONFrom([]byte(fmt.Sprintf(`{"seq":%d,"session":{"state":"s%d"}}`, i, i%3))),
+			Viewed:  null.BoolFrom(i%3 == 0),
+		}
+		if err := m.Insert(ctx, c.db.Load(), boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	viewed := false
+	f := &MessagesFilter{
+		Topics: []string{"topic-0"},
+		Viewed: &viewed,
+		Fields: []MessageFieldFilter{{Path: []string{"session", "state"}, Value: "s2"}},
+	}
+
+	// the unviewed topic-0 messages in state s2 are 2 and 8 (seq)
+	var got []string
+	p := Page{Limit: 1}
+	for range 3 {
+		res, next, err := c.GetMessages(ctx, f, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range res {
+			got = append(got, string(m.Message.JSON))
+		}
+		if next == "" {
+			break
+		}
+		p.Cursor = next
+	}
+	// newest first
+	want := []string{
+		`{"seq":8,"session":{"state":"s2"}}`,
+		`{"seq":2,"session":{"state":"s2"}}`,
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("messages = %v, want %v", got, want)
+	}
+
+	total, approximate, err := c.GetMessagesCount(ctx, f)
+	if err != nil || total != 2 || approximate {
+		t.Errorf("count = %d (approximate %t), err = %v, want 2", total, approximate, err)
+	}
+	if n, err := c.EstimateMessagesCount(ctx, f); err != nil || n != 2 {
+		t.Errorf("estimate = %d, err = %v, want 2", n, err)
+	}
+
+	// the contains filter is evaluated on each message on SQLite
+	f = &MessagesFilter{Contains: `{"session":{"state":"s2"}}`, contains: map[string]any{"session": map[string]any{"state": "s2"}}}
+	res, _, err := c.GetMessages(ctx, f, Page{Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// s2 are the messages 8, 5 and 2 (seq), newest first
+	if len(res) != 2 || string(res[0].Message.JSON) != `{"seq":5,"session":{"state":"s2"}}` {
+		t.Errorf("contains page = %v", res)
+	}
+	if total, _, err := c.GetMessagesCount(ctx, f); err != nil || total != 3 {
+		t.Errorf("contains count = %d, err = %v, want 3", total, err)
+	}
+}
+
+func TestGetLogsPagesOnSQLite(t *testing.T) {
+	ctx := context.Background()
+	c := newSQLiteConnection(t)
+
+	for i := range 5 {
+		l := &models.Log{Client: "c1", Level: "info", Message: null.StringFrom(fmt.Sprint(i))}
+		if err := l.Insert(ctx, c.db.Load(), boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	p := Page{Limit: 2}
+	for {
+		res, next, err := c.GetLogs(ctx, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range res {
+			got = append(got, l.Message.String)
+		}
+		if next == "" {
+			break
+		}
+		p.Cursor = next
+	}
+	if fmt.Sprint(got) != "[4 3 2 1 0]" {
+		t.Errorf("logs = %v, want [4 3 2 1 0]", got)
+	}
+
+	if n, err := c.EstimateLogsCount(ctx); err != nil || n != 5 {
+		t.Errorf("estimate = %d, err = %v, want 5", n, err)
+	}
+}
@@ -1,14 +1,21 @@
 package connection
 
-import gopxgrid "github.com/vkumov/go-pxgrid"
+import (
+	gopxgrid "github.com/vkumov/go-pxgrid"
+
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
+)
 
 type rawAttributes struct {
-	State       string   `json:"state,omitempty"`
-	DNS         string   `json:"dns,omitempty"`
-	DNSStrategy int      `json:"dns_strategy,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Verify      string   `json:"verify,omitempty"`
-	CA          []string `json:"ca,omitempty"`
+	State       string             `json:"state,omitempty"`
+	DNS         string             `json:"dns,omitempty"`
+	DNSStrategy int                `json:"dns_strategy,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Verify      string             `json:"verify,omitempty"`
+	CA          []string           `json:"ca,omitempty"`
+	Retention   *rawRetention      `json:"retention,omitempty"`
+	Sinks       []forwarder.Config `json:"sinks,omitempty"`
+	LogLevel    *LogLevel          `json:"log_level,omitempty"`
 }
 
 func strategyFromRaw(strategy int) gopxgrid.INETFamilyStrategy {
@@ -34,6 +41,9 @@ func (c *Connection) getRawAttributes() rawAttributes {
 		DNSStrategy: int(c.dnsStrategy),
 		CA:          make([]string, len(c.tlsCfg.CA)),
 		Verify:      "all",
+		Retention:   c.retention.toRaw(),
+		Sinks:       c.sinks,
+		LogLevel:    c.logLevel.toRaw(),
 	}
 	if c.tlsCfg.InsecureSkipVerify {
 		attributes.Verify = "none"
@@ -0,0 +1,186 @@
+package connection
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	pxdb "github.com/vkumov/go-pxgrider/server/internal/db"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
+	"github.com/vkumov/go-pxgrider/server/internal/secrets"
+	"github.com/vkumov/go-pxgrider/server/internal/utils"
+)
+
+// ReencryptReport counts the connections processed by ReencryptCredentials
+type ReencryptReport struct {
+	Total     int
+	Rewritten int
+}
+
+// ReencryptCredentials seals the stored credentials and sink secrets of every
+// connection with the primary key of the configured keyring. Plain text rows and
+// rows sealed with other keys are rewritten, so old keys can be dropped from the
+// keyring afterwards.
+func ReencryptCredentials(ctx context.Context, db *sql.DB, dialect pxdb.Dialect, log *zerolog.Logger) (ReencryptReport, error) {
+	var report ReencryptReport
+
+	k := secrets.Current()
+	if k == nil {
+		return report, errors.New("no encryption keys are configured")
+	}
+
+	clients, err := models.Clients(qm.Select(models.ClientColumns.ID)).All(ctx, db)
+	if err != nil {
+		return report, err
+	}
+
+	for _, cl := range clients {
+		rewritten, err := reencryptClient(ctx, db, dialect, k, cl.ID)
+		if err != nil {
+			return report, fmt.Errorf("failed to re-encrypt credentials of connection %s: %w", cl.ID, err)
+		}
+
+		report.Total++
+		if rewritten {
+			report.Rewritten++
+			log.Debug().Str("id", cl.ID).Str("key", k.Primary()).Msg("credentials re-encrypted")
+		}
+	}
+
+	return report, nil
+}
+
+func reencryptClient(ctx context.Context, db *sql.DB, dialect pxdb.Dialect, k *secrets.Keyring, id string) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	q := []qm.QueryMod{
+		qm.Select(models.ClientColumns.ID, models.ClientColumns.Credentials,
+			models.ClientColumns.Topics, models.ClientColumns.Attributes),
+		models.ClientWhere.ID.EQ(id),
+	}
+	q = append(q, dialect.ForUpdate()...)
+
+	cl, err := models.Clients(q...).One(ctx, tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var columns []string
+	if cl.Credentials.Valid && !utils.IsEmptyJSON(cl.Credentials.JSON) {
+		if kid, sealed := secrets.KeyID(cl.Credentials.JSON); !sealed || kid != k.Primary() {
+			plain, err := k.Open(cl.Credentials.JSON, []byte(id))
+			if err != nil {
+				return false, err
+			}
+			sealed, err := k.Seal(plain, []byte(id))
+			if err != nil {
+				return false, err
+			}
+			cl.Credentials = null.JSONFrom(sealed)
+			columns = append(columns, models.ClientColumns.Credentials)
+		}
+	}
+
+	for column, doc := range map[string]*null.JSON{
+		models.ClientColumns.Topics:     &cl.Topics,
+		models.ClientColumns.Attributes: &cl.Attributes,
+	} {
+		if !doc.Valid || utils.IsEmptyJSON(doc.JSON) {
+			continue
+		}
+		resealed, ok, err := resealSinks(doc.JSON)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", column, err)
+		}
+		if ok {
+			doc.JSON = resealed
+			columns = append(columns, column)
+		}
+	}
+
+	if len(columns) == 0 {
+		return false, nil
+	}
+	if _, err := cl.Update(ctx, tx, boil.Whitelist(columns...)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// resealSinks rewrites the sinks found in the document, which seals their
+// secrets with the primary key. It reports false if there are none.
+func resealSinks(doc []byte) ([]byte, bool, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, false, err
+	}
+
+	found, err := resealSinksIn(v)
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	resealed, err := json.Marshal(v)
+	return resealed, err == nil, err
+}
+
+// resealSinksIn replaces the elements of "sinks" arrays of v by their config
+func resealSinksIn(v any) (bool, error) {
+	found := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if list, ok := child.([]any); ok && key == "sinks" {
+				for i, raw := range list {
+					data, err := json.Marshal(raw)
+					if err != nil {
+						return false, err
+					}
+					var cfg forwarder.Config
+					if err := json.Unmarshal(data, &cfg); err != nil {
+						return false, err
+					}
+					list[i] = cfg
+					found = true
+				}
+				continue
+			}
+
+			ok, err := resealSinksIn(child)
+			if err != nil {
+				return false, err
+			}
+			found = found || ok
+		}
+	case []any:
+		for _, child := range v {
+			ok, err := resealSinksIn(child)
+			if err != nil {
+				return false, err
+			}
+			found = found || ok
+		}
+	}
+
+	return found, nil
+}
@@ -0,0 +1,184 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+type (
+	RefreshAction int
+
+	RefreshResult struct {
+		ConnectionID string
+		Action       RefreshAction
+		Changed      []string
+		Err          error
+	}
+
+	RefreshResultSlice []RefreshResult
+)
+
+const (
+	RefreshActionUnchanged RefreshAction = iota
+	RefreshActionAdded
+	RefreshActionUpdated
+	RefreshActionRemoved
+)
+
+// ApplyDBData compares the connection with its database row and applies the differences.
+// Changes of nodes, credentials, client name or TLS/DNS attributes rebuild the pxGrid consumer
+// and re-subscribe active subscriptions, changes of connection sinks restart them.
+// Subscriptions themselves are not touched, log levels are applied to the logger.
+func (c *Connection) ApplyDBData(ctx context.Context, cl *models.Client) ([]string, error) {
+	fresh := &Connection{id: c.id}
+	if err := fresh.loadDBData(cl); err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	changed := make([]string, 0)
+
+	if c.logLevel != fresh.logLevel {
+		if err := c.log.SetLevels(fresh.logLevel.Stdout, fresh.logLevel.DB); err != nil {
+			c.lock.Unlock()
+			return nil, fmt.Errorf("failed to apply log level for connection %s: %w", c.id, err)
+		}
+		c.logLevel = fresh.logLevel
+		changed = append(changed, "log_level")
+	}
+
+	if c.friendlyName != fresh.friendlyName {
+		c.friendlyName = fresh.friendlyName
+		changed = append(changed, models.ClientColumns.FriendlyName)
+	}
+	if c.owner != fresh.owner {
+		c.owner = fresh.owner
+		changed = append(changed, models.ClientColumns.Owner)
+	}
+	if c.clientName != fresh.clientName {
+		c.clientName = fresh.clientName
+		changed = append(changed, models.ClientColumns.ClientName)
+	}
+	if c.primaryNode != fresh.primaryNode {
+		c.primaryNode = fresh.primaryNode
+		changed = append(changed, models.ClientColumns.Primary)
+	}
+	if !nodesEqual(c.secondaryNodes, fresh.secondaryNodes) {
+		c.secondaryNodes = fresh.secondaryNodes
+		changed = append(changed, models.ClientColumns.Secondaries)
+	}
+	if !reflect.DeepEqual(c.credentials, fresh.credentials) {
+		c.credentials = fresh.credentials
+		changed = append(changed, models.ClientColumns.Credentials)
+	}
+	sinksChanged := !reflect.DeepEqual(c.sinks, fresh.sinks)
+	if sinksChanged {
+		c.sinks = fresh.sinks
+		changed = append(changed, "sinks")
+	}
+
+	oldAttrs, freshAttrs := c.getRawAttributes(), fresh.getRawAttributes()
+	oldAttrs.Sinks, freshAttrs.Sinks = nil, nil
+	oldAttrs.LogLevel, freshAttrs.LogLevel = nil, nil
+	if !reflect.DeepEqual(oldAttrs, freshAttrs) {
+		c.state = fresh.state
+		c.description = fresh.description
+		c.dns = fresh.dns
+		c.dnsStrategy = fresh.dnsStrategy
+		c.tlsCfg = fresh.tlsCfg
+		c.retention = fresh.retention
+		changed = append(changed, models.ClientColumns.Attributes)
+	}
+
+	needsRebuild := false
+	for _, cl := range changed {
+		switch cl {
+		case models.ClientColumns.Primary, models.ClientColumns.Secondaries, models.ClientColumns.Credentials,
+			models.ClientColumns.ClientName, models.ClientColumns.Attributes:
+			needsRebuild = true
+		}
+	}
+	c.lock.Unlock()
+
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	if sinksChanged {
+		if err := c.startSinks(); err != nil {
+			c.log.Error().Err(err).Msg("Failed to restart connection sinks")
+		}
+	}
+
+	c.log.Info().Strs("changed", changed).Msg("Connection refreshed from database")
+
+	if !needsRebuild {
+		return changed, nil
+	}
+
+	if _, err := c.RebuildPxGridConsumer(); err != nil {
+		return changed, err
+	}
+
+	return changed, c.resubscribeAll(ctx)
+}
+
+// resubscribeAll moves every subscription to the current pxGrid consumer
+func (c *Connection) resubscribeAll(ctx context.Context) error {
+	for _, s := range c.AllSubscriptions() {
+		if err := s.close(); err != nil {
+			s.log.Warn().Err(err).Msg("Failed to unsubscribe before re-subscribing")
+		}
+	}
+
+	if err := c.RestoreSubscriptions(ctx); err != nil {
+		return fmt.Errorf("failed to re-subscribe connection %s: %w", c.id, err)
+	}
+
+	return nil
+}
+
+func nodesEqual(a, b []Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (a RefreshAction) ToProto() pb.RefreshAction {
+	switch a {
+	case RefreshActionAdded:
+		return pb.RefreshAction_REFRESH_ACTION_ADDED
+	case RefreshActionUpdated:
+		return pb.RefreshAction_REFRESH_ACTION_UPDATED
+	case RefreshActionRemoved:
+		return pb.RefreshAction_REFRESH_ACTION_REMOVED
+	default:
+		return pb.RefreshAction_REFRESH_ACTION_UNCHANGED
+	}
+}
+
+func (s RefreshResultSlice) ToProto() []*pb.ConnectionRefreshResult {
+	res := make([]*pb.ConnectionRefreshResult, 0, len(s))
+	for _, r := range s {
+		p := &pb.ConnectionRefreshResult{
+			ConnectionId: r.ConnectionID,
+			Action:       r.Action.ToProto(),
+			Changed:      r.Changed,
+		}
+		if r.Err != nil {
+			p.Error = r.Err.Error()
+		}
+		res = append(res, p)
+	}
+	return res
+}
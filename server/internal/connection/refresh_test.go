@@ -0,0 +1,64 @@
+package connection
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+func newTestConnection(t *testing.T) *Connection {
+	t.Helper()
+
+	log := zerolog.New(io.Discard).Level(zerolog.InfoLevel).With().Timestamp().Logger()
+	c := New(nil, nil, nil, "c1", "alice", &log, io.Discard)
+	t.Cleanup(c.log.Stop)
+
+	return c
+}
+
+func TestApplyDBDataLogLevel(t *testing.T) {
+	c := newTestConnection(t)
+	row := &models.Client{
+		ID:         "c1",
+		Owner:      "alice",
+		Attributes: null.JSONFrom([]byte(`{"state":"ENABLED"}`)),
+	}
+	if err := c.loadDBData(row); err != nil {
+		t.Fatal(err)
+	}
+
+	row.Attributes = null.JSONFrom([]byte(`{"state":"ENABLED","log_level":{"stdout":"debug","db":"warn"}}`))
+	changed, err := c.ApplyDBData(context.Background(), row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(changed, []string{"log_level"}) {
+		t.Errorf("changed = %v, want [log_level]", changed)
+	}
+	if got := c.LogLevel(); got != (LogLevel{Stdout: "debug", DB: "warn"}) {
+		t.Errorf("log level = %+v", got)
+	}
+	if stdout, db := c.log.Levels(); stdout != zerolog.DebugLevel || db != zerolog.WarnLevel {
+		t.Errorf("logger levels = %s/%s, want debug/warn", stdout, db)
+	}
+
+	// an unchanged row changes nothing
+	if changed, err := c.ApplyDBData(context.Background(), row); err != nil || len(changed) != 0 {
+		t.Errorf("changed = %v, err = %v", changed, err)
+	}
+
+	// removing the override restores the server level
+	row.Attributes = null.JSONFrom([]byte(`{"state":"ENABLED"}`))
+	if _, err := c.ApplyDBData(context.Background(), row); err != nil {
+		t.Fatal(err)
+	}
+	if stdout, db := c.log.Levels(); stdout != zerolog.InfoLevel || db != zerolog.InfoLevel {
+		t.Errorf("logger levels = %s/%s, want info/info", stdout, db)
+	}
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ettle/strcase"
 	"github.com/rs/zerolog"
 	gopxgrid "github.com/vkumov/go-pxgrid"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 
 	pxgrider_proto "github.com/vkumov/go-pxgrider/pkg"
 	"github.com/vkumov/go-pxgrider/server/internal/connection/mappings"
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
+	"github.com/vkumov/go-pxgrider/server/internal/tracing"
 )
 
 type (
@@ -171,28 +177,44 @@ func (c *Connection) CallServiceMethod(ctx context.Context, service, method, nod
 
 	node = strings.TrimSpace(node)
 
-	c.log.Debug().
+	ctx, span := tracing.Start(ctx, "pxgrid.rest",
+		attribute.String("pxgrid.connection", c.id),
+		attribute.String("pxgrid.service", service),
+		attribute.String("pxgrid.method", method),
+		attribute.String("pxgrid.node", node),
+	)
+
+	c.log.Debug().Ctx(ctx).
 		Str("service", service).Str("method", method).Interface("params", pMap).Str("node", node).
 		Msg("Calling service method")
 	caller := svc.AnyREST(method, pMap)
 
 	var res gopxgrid.FullResponse[any]
+	start := time.Now()
 	if node != "" {
 		res, err = caller.DoOnNodeByName(ctx, node)
 	} else {
 		res, err = caller.Do(ctx)
 	}
 
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(res.StatusCode)
+		span.SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+	}
+	metrics.RESTDuration.WithLabelValues(service, method, status).Observe(time.Since(start).Seconds())
+	tracing.End(span, err)
+
 	if err != nil {
 		return nil, err
 	}
 
 	if c.log.GetLevel() <= zerolog.DebugLevel {
-		c.log.Debug().
+		c.log.Debug().Ctx(ctx).
 			Int("status_code", res.StatusCode).Str("body", res.Body).
 			Interface("result", res.Result).Msg("Service method result")
 	} else {
-		c.log.Info().
+		c.log.Info().Ctx(ctx).
 			Int("status_code", res.StatusCode).Msg("Service method executed")
 	}
 
@@ -0,0 +1,303 @@
+package connection
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+// retentionBatchSize bounds the rows removed by a single DELETE, so that
+// retention doesn't hold long transactions on large tables
+const retentionBatchSize = 5000
+
+type (
+	// Retention limits stored messages and logs of a connection, zero means no limit
+	Retention struct {
+		MessagesMaxAge  time.Duration
+		MessagesMaxRows int64
+		LogsMaxAge      time.Duration
+		LogsMaxRows     int64
+	}
+
+	// RetentionOverride replaces the set fields of the server wide retention
+	RetentionOverride struct {
+		MessagesMaxAge  *time.Duration
+		MessagesMaxRows *int64
+		LogsMaxAge      *time.Duration
+		LogsMaxRows     *int64
+	}
+
+	RetentionReport struct {
+		MessagesDeleted int64
+		LogsDeleted     int64
+	}
+
+	rawRetention struct {
+		MessagesMaxAge  string `json:"messages_max_age,omitempty"`
+		MessagesMaxRows *int64 `json:"messages_max_rows,omitempty"`
+		LogsMaxAge      string `json:"logs_max_age,omitempty"`
+		LogsMaxRows     *int64 `json:"logs_max_rows,omitempty"`
+	}
+)
+
+func (o RetentionOverride) IsZero() bool {
+	return o.MessagesMaxAge == nil && o.MessagesMaxRows == nil && o.LogsMaxAge == nil && o.LogsMaxRows == nil
+}
+
+// Apply returns r with the set fields of the override
+func (o RetentionOverride) Apply(r Retention) Retention {
+	if o.MessagesMaxAge != nil {
+		r.MessagesMaxAge = *o.MessagesMaxAge
+	}
+	if o.MessagesMaxRows != nil {
+		r.MessagesMaxRows = *o.MessagesMaxRows
+	}
+	if o.LogsMaxAge != nil {
+		r.LogsMaxAge = *o.LogsMaxAge
+	}
+	if o.LogsMaxRows != nil {
+		r.LogsMaxRows = *o.LogsMaxRows
+	}
+
+	return r
+}
+
+func (o RetentionOverride) toRaw() *rawRetention {
+	if o.IsZero() {
+		return nil
+	}
+
+	raw := &rawRetention{
+		MessagesMaxRows: o.MessagesMaxRows,
+		LogsMaxRows:     o.LogsMaxRows,
+	}
+	if o.MessagesMaxAge != nil {
+		raw.MessagesMaxAge = o.MessagesMaxAge.String()
+	}
+	if o.LogsMaxAge != nil {
+		raw.LogsMaxAge = o.LogsMaxAge.String()
+	}
+
+	return raw
+}
+
+func (r *rawRetention) toOverride() RetentionOverride {
+	if r == nil {
+		return RetentionOverride{}
+	}
+
+	o := RetentionOverride{
+		MessagesMaxRows: r.MessagesMaxRows,
+		LogsMaxRows:     r.LogsMaxRows,
+	}
+	if d, err := time.ParseDuration(r.MessagesMaxAge); err == nil {
+		o.MessagesMaxAge = &d
+	}
+	if d, err := time.ParseDuration(r.LogsMaxAge); err == nil {
+		o.LogsMaxAge = &d
+	}
+
+	return o
+}
+
+func RetentionOverrideFromProto(p *pb.Retention) RetentionOverride {
+	var o RetentionOverride
+	if p == nil {
+		return o
+	}
+
+	if p.MessagesMaxAge != nil {
+		d := p.GetMessagesMaxAge().AsDuration()
+		o.MessagesMaxAge = &d
+	}
+	o.MessagesMaxRows = p.MessagesMaxRows
+	if p.LogsMaxAge != nil {
+		d := p.GetLogsMaxAge().AsDuration()
+		o.LogsMaxAge = &d
+	}
+	o.LogsMaxRows = p.LogsMaxRows
+
+	return o
+}
+
+func (o RetentionOverride) ToProto() *pb.Retention {
+	if o.IsZero() {
+		return nil
+	}
+
+	p := &pb.Retention{
+		MessagesMaxRows: o.MessagesMaxRows,
+		LogsMaxRows:     o.LogsMaxRows,
+	}
+	if o.MessagesMaxAge != nil {
+		p.MessagesMaxAge = durationpb.New(*o.MessagesMaxAge)
+	}
+	if o.LogsMaxAge != nil {
+		p.LogsMaxAge = durationpb.New(*o.LogsMaxAge)
+	}
+
+	return p
+}
+
+func (c *Connection) Retention() RetentionOverride {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.retention
+}
+
+// EnforceRetention deletes messages and logs beyond the retention of the connection,
+// which is the server wide one with the connection override applied
+func (c *Connection) EnforceRetention(ctx context.Context, global Retention) (RetentionReport, error) {
+	r := c.Retention().Apply(global)
+
+	var (
+		report RetentionReport
+		errs   []error
+	)
+
+	if r.MessagesMaxAge > 0 {
+		n, err := c.deleteMessages(ctx,
+			models.MessageWhere.Timestamp.LT(null.TimeFrom(time.Now().Add(-r.MessagesMaxAge))),
+		)
+		report.MessagesDeleted += n
+		errs = append(errs, err)
+	}
+	if r.MessagesMaxRows > 0 {
+		n, err := c.deleteMessagesBeyond(ctx, r.MessagesMaxRows)
+		report.MessagesDeleted += n
+		errs = append(errs, err)
+	}
+
+	if r.LogsMaxAge > 0 {
+		n, err := c.deleteLogs(ctx,
+			models.LogWhere.Timestamp.LT(null.TimeFrom(time.Now().Add(-r.LogsMaxAge))),
+		)
+		report.LogsDeleted += n
+		errs = append(errs, err)
+	}
+	if r.LogsMaxRows > 0 {
+		n, err := c.deleteLogsBeyond(ctx, r.LogsMaxRows)
+		report.LogsDeleted += n
+		errs = append(errs, err)
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		c.log.Error().Err(err).Msg("Failed to apply retention")
+	}
+	if report.MessagesDeleted > 0 || report.LogsDeleted > 0 {
+		c.log.Info().Int64("messages", report.MessagesDeleted).Int64("logs", report.LogsDeleted).
+			Msg("Retention applied")
+	}
+
+	return report, err
+}
+
+// deleteMessagesBeyond keeps only the newest max messages
+func (c *Connection) deleteMessagesBeyond(ctx context.Context, max int64) (int64, error) {
+	db := c.db.Load()
+
+	oldest, err := models.Messages(
+		qm.Select(models.MessageColumns.ID),
+		models.MessageWhere.Client.EQ(c.id),
+		qm.OrderBy(models.MessageColumns.ID+" DESC"),
+		qm.Offset(int(max)),
+		qm.Limit(1),
+	).One(ctx, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return c.deleteMessages(ctx, models.MessageWhere.ID.LTE(oldest.ID))
+}
+
+// deleteLogsBeyond keeps only the newest max logs
+func (c *Connection) deleteLogsBeyond(ctx context.Context, max int64) (int64, error) {
+	db := c.db.Load()
+
+	oldest, err := models.Logs(
+		qm.Select(models.LogColumns.ID),
+		models.LogWhere.Client.EQ(c.id),
+		qm.OrderBy(models.LogColumns.ID+" DESC"),
+		qm.Offset(int(max)),
+		qm.Limit(1),
+	).One(ctx, db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return c.deleteLogs(ctx, models.LogWhere.ID.LTE(oldest.ID))
+}
+
+// deleteMessages deletes messages of the connection matching mods in batches
+// of retentionBatchSize
+func (c *Connection) deleteMessages(ctx context.Context, mods ...qm.QueryMod) (int64, error) {
+	db := c.db.Load()
+	mods = append([]qm.QueryMod{
+		qm.Select(models.MessageColumns.ID),
+		models.MessageWhere.Client.EQ(c.id),
+		qm.Limit(retentionBatchSize),
+	}, mods...)
+
+	var total int64
+	for {
+		batch, err := models.Messages(mods...).All(ctx, db)
+		if err != nil || len(batch) == 0 {
+			return total, err
+		}
+
+		ids := make([]int64, len(batch))
+		for i, m := range batch {
+			ids[i] = m.ID
+		}
+		n, err := models.Messages(models.MessageWhere.ID.IN(ids)).DeleteAll(ctx, db)
+		total += n
+		if err != nil || len(batch) < retentionBatchSize {
+			return total, err
+		}
+	}
+}
+
+// deleteLogs deletes logs of the connection matching mods in batches of
+// retentionBatchSize
+func (c *Connection) deleteLogs(ctx context.Context, mods ...qm.QueryMod) (int64, error) {
+	db := c.db.Load()
+	mods = append([]qm.QueryMod{
+		qm.Select(models.LogColumns.ID),
+		models.LogWhere.Client.EQ(c.id),
+		qm.Limit(retentionBatchSize),
+	}, mods...)
+
+	var total int64
+	for {
+		batch, err := models.Logs(mods...).All(ctx, db)
+		if err != nil || len(batch) == 0 {
+			return total, err
+		}
+
+		ids := make([]int64, len(batch))
+		for i, l := range batch {
+			ids[i] = l.ID
+		}
+		n, err := models.Logs(models.LogWhere.ID.IN(ids)).DeleteAll(ctx, db)
+		total += n
+		if err != nil || len(batch) < retentionBatchSize {
+			return total, err
+		}
+	}
+}
@@ -146,13 +146,6 @@ func (c *Connection) SetCertificateBasedAuth(certPEMBlock, keyPEMBlock string, c
 	c.unsaved[models.ClientColumns.Credentials] = struct{}{}
 }
 
-func (c *Connection) SetLogLevel(level string) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-
-	return c.log.Level(level)
-}
-
 // func (c *Connection) SetLogger(logger *zerolog.Logger) {
 // 	c.lock.Lock()
 // 	defer c.lock.Unlock()
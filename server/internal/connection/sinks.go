@@ -0,0 +1,155 @@
+package connection
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
+)
+
+// SetSinks replaces the sinks the messages of the subscription are forwarded to
+func (c *Connection) SetSinks(ctx context.Context, sname string, topic TopicName, sinks []forwarder.Config) (*Subscription, error) {
+	s := c.FindSubscription(sname, topic)
+	if s == nil {
+		return nil, ErrSubNotInitialized
+	}
+
+	forwarder.KeepSecrets(sinks, s.Sinks())
+	if err := c.setSubscriptionSinks(s, sinks); err != nil {
+		return nil, err
+	}
+	c.log.Info().Str("service", s.Service).Str("topic", s.Topic).Int("sinks", len(sinks)).Msg("Subscription sinks updated")
+
+	c.lock.Lock()
+	c.unsaved[models.ClientColumns.Topics] = struct{}{}
+	c.lock.Unlock()
+
+	return s, c.storeUnsaved(ctx)
+}
+
+// setSubscriptionSinks starts forwarding to the sinks, stopping the previous forwarder
+func (c *Connection) setSubscriptionSinks(s *Subscription, sinks []forwarder.Config) error {
+	var (
+		fwd *forwarder.Forwarder
+		err error
+	)
+	if len(sinks) > 0 {
+		fwd, err = forwarder.New(c.id+"/"+s.Service+"/"+s.Topic, sinks, s.log)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.lock.Lock()
+	old := s.fwd
+	s.sinks = slices.Clone(sinks)
+	s.fwd = fwd
+	s.lock.Unlock()
+
+	old.Close()
+
+	return nil
+}
+
+func (s *Subscription) Sinks() []forwarder.Config {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return slices.Clone(s.sinks)
+}
+
+// SetConnectionSinks replaces the sinks the messages of every subscription of the
+// connection are forwarded to
+func (c *Connection) SetConnectionSinks(ctx context.Context, sinks []forwarder.Config) ([]forwarder.Config, error) {
+	forwarder.KeepSecrets(sinks, c.ConnectionSinks())
+
+	fwd, err := c.newConnectionForwarder(sinks)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	old := c.fwd
+	c.sinks = slices.Clone(sinks)
+	c.fwd = fwd
+	c.unsaved[models.ClientColumns.Attributes] = struct{}{}
+	c.lock.Unlock()
+
+	old.Close()
+	c.log.Info().Int("sinks", len(sinks)).Msg("Connection sinks updated")
+
+	return c.ConnectionSinks(), c.storeUnsaved(ctx)
+}
+
+func (c *Connection) ConnectionSinks() []forwarder.Config {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return slices.Clone(c.sinks)
+}
+
+// startSinks (re)starts forwarding to the stored connection sinks
+func (c *Connection) startSinks() error {
+	fwd, err := c.newConnectionForwarder(c.ConnectionSinks())
+
+	c.lock.Lock()
+	old := c.fwd
+	c.fwd = fwd
+	c.lock.Unlock()
+
+	old.Close()
+
+	return err
+}
+
+func (c *Connection) stopSinks() {
+	c.lock.Lock()
+	old := c.fwd
+	c.fwd = nil
+	c.lock.Unlock()
+
+	old.Close()
+}
+
+func (c *Connection) newConnectionForwarder(sinks []forwarder.Config) (*forwarder.Forwarder, error) {
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+
+	l := c.log.With().Str("sinks", "connection").Logger()
+	return forwarder.New(c.id, sinks, &l)
+}
+
+// forward hands the stored message to the sinks of the subscription and of the
+// connection. A forwarder replaced meanwhile parks the message in its on-disk
+// queue, which is shared with the new forwarder of the same sinks.
+func (c *Connection) forward(s *Subscription, m *models.Message) {
+	s.lock.Lock()
+	sfwd := s.fwd
+	s.lock.Unlock()
+
+	c.lock.Lock()
+	cfwd := c.fwd
+	c.lock.Unlock()
+
+	if sfwd == nil && cfwd == nil {
+		return
+	}
+
+	e := forwarder.Event{
+		ConnectionID: m.Client,
+		Service:      s.Service,
+		Topic:        m.Topic,
+		MessageID:    m.ID,
+		Timestamp:    m.Timestamp.Time,
+		Message:      m.Message.JSON,
+	}
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+
+	sfwd.Publish(e)
+	cfwd.Publish(e)
+}
@@ -0,0 +1,212 @@
+package connection
+
+import (
+	"context"
+	"sync"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+type (
+	MessageEvent struct {
+		Service string
+		Message *models.Message
+	}
+
+	MessageListener struct {
+		C <-chan MessageEvent
+
+		c       chan MessageEvent
+		service ServiceName
+		topic   TopicName
+		lagged  bool
+		hub     *messageHub
+	}
+
+	messageHub struct {
+		lock      sync.Mutex
+		listeners map[*MessageListener]struct{}
+	}
+)
+
+const (
+	listenerQueueSize = 256
+	backlogBatchSize  = 500
+)
+
+// ErrListenerLagged is returned to clients with codes.Aborted, they resume from
+// the last received message
+var ErrListenerLagged = status.Error(codes.Aborted, "message listener lagged behind, resume from the last received message")
+
+func newMessageHub() *messageHub {
+	return &messageHub{listeners: make(map[*MessageListener]struct{})}
+}
+
+func (h *messageHub) listen(service ServiceName, topic TopicName) *MessageListener {
+	ch := make(chan MessageEvent, listenerQueueSize)
+	l := &MessageListener{
+		C:       ch,
+		c:       ch,
+		service: service,
+		topic:   topic,
+		hub:     h,
+	}
+
+	h.lock.Lock()
+	h.listeners[l] = struct{}{}
+	h.lock.Unlock()
+
+	return l
+}
+
+func (h *messageHub) publish(service string, m *models.Message) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for l := range h.listeners {
+		if l.service != "" && string(l.service) != service {
+			continue
+		}
+		if l.topic != "" && string(l.topic) != m.Topic {
+			continue
+		}
+
+		select {
+		case l.c <- MessageEvent{Service: service, Message: m}:
+		default:
+			// slow listener, drop it so it can resume from the database
+			l.lagged = true
+			delete(h.listeners, l)
+			close(l.c)
+		}
+	}
+}
+
+func (h *messageHub) closeAll() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for l := range h.listeners {
+		delete(h.listeners, l)
+		close(l.c)
+	}
+}
+
+// Close detaches the listener from the connection
+func (l *MessageListener) Close() {
+	l.hub.lock.Lock()
+	defer l.hub.lock.Unlock()
+
+	if _, ok := l.hub.listeners[l]; ok {
+		delete(l.hub.listeners, l)
+		close(l.c)
+	}
+}
+
+// Err reports why the listener channel was closed
+func (l *MessageListener) Err() error {
+	l.hub.lock.Lock()
+	defer l.hub.lock.Unlock()
+
+	if l.lagged {
+		return ErrListenerLagged
+	}
+	return nil
+}
+
+// ListenMessages returns a listener receiving every message persisted from now on,
+// optionally filtered by service and topic
+func (c *Connection) ListenMessages(sname string, topic TopicName) (*MessageListener, error) {
+	var service ServiceName
+	if sname != "" {
+		var err error
+		if service, err = c.normalizeServiceName(sname); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.hub.listen(service, topic), nil
+}
+
+// GetMessagesBacklog calls fn for every stored message with ID greater than afterID,
+// in ascending order, optionally filtered by service and topic. Messages stored
+// before their service was recorded are matched by the currently subscribed topics.
+func (c *Connection) GetMessagesBacklog(ctx context.Context, sname string, topic TopicName, afterID int64,
+	fn func(service string, m *models.Message) error) error {
+	topicServices := c.subscribedTopics()
+
+	var filter []qm.QueryMod
+	if topic != "" {
+		filter = append(filter, models.MessageWhere.Topic.EQ(string(topic)))
+	}
+	if sname != "" {
+		service, err := c.normalizeServiceName(sname)
+		if err != nil {
+			return err
+		}
+
+		var topics []string
+		for t, svc := range topicServices {
+			if svc == string(service) {
+				topics = append(topics, t)
+			}
+		}
+
+		byService := qm.Expr(models.MessageWhere.Service.EQ(null.StringFrom(string(service))))
+		if len(topics) > 0 {
+			byService = qm.Expr(byService, qm.Or2(qm.Expr(
+				models.MessageWhere.Service.IsNull(),
+				models.MessageWhere.Topic.IN(topics),
+			)))
+		}
+		filter = append(filter, byService)
+	}
+
+	for {
+		q := append([]qm.QueryMod{
+			models.MessageWhere.Client.EQ(c.id),
+			models.MessageWhere.ID.GT(afterID),
+			qm.OrderBy(models.MessageColumns.ID + " ASC"),
+			qm.Limit(backlogBatchSize),
+		}, filter...)
+
+		batch, err := models.Messages(q...).All(ctx, c.db.Load())
+		if err != nil {
+			return err
+		}
+
+		for _, m := range batch {
+			service := m.Service.String
+			if !m.Service.Valid {
+				service = topicServices[m.Topic]
+			}
+			if err := fn(service, m); err != nil {
+				return err
+			}
+			afterID = m.ID
+		}
+
+		if len(batch) < backlogBatchSize {
+			return nil
+		}
+	}
+}
+
+func (c *Connection) subscribedTopics() map[string]string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	res := make(map[string]string)
+	for svc, topics := range c.topics {
+		for topic := range topics {
+			res[string(topic)] = string(svc)
+		}
+	}
+
+	return res
+}
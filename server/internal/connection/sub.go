@@ -5,16 +5,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 	gopxgrid "github.com/vkumov/go-pxgrid"
-	"github.com/volatiletech/null/v8"
-	"github.com/volatiletech/sqlboiler/v4/boil"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/vkumov/go-pxgrider/pkg"
 	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
 )
 
 type (
@@ -26,9 +27,22 @@ type (
 		Service     string
 		Topic       string
 
-		s   *gopxgrid.Subscription[any]
-		ps  gopxgrid.PubSub
-		log *zerolog.Logger
+		s     *gopxgrid.Subscription[any]
+		ps    gopxgrid.PubSub
+		log   *zerolog.Logger
+		lock  sync.Mutex
+		done  chan struct{}
+		sinks []forwarder.Config
+		fwd   *forwarder.Forwarder
+
+		// ctx is cancelled with done, it bounds calls made on behalf of s
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		lastConnected     time.Time
+		lastError         error
+		reconnectAttempts atomic.Int64
+		messagesReceived  atomic.Int64
 	}
 
 	SubscriptionSlice []*Subscription
@@ -39,69 +53,122 @@ var (
 	ErrPubSubServiceNotInitialized = errors.New("pubsub service is not initialized")
 )
 
-func (c *Connection) Subscribe(ctx context.Context, sname string, topic TopicName) (*Subscription, error) {
-	cnsm := c.pxCnsm.Load()
-	if cnsm == nil {
-		return nil, fmt.Errorf("pxgrid consumer is not initialized")
-	}
-
+func (c *Connection) Subscribe(ctx context.Context, sname string, topic TopicName, sinks []forwarder.Config) (*Subscription, error) {
 	service, err := c.normalizeServiceName(sname)
 	if err != nil {
 		return nil, err
 	}
 
-	var svc gopxgrid.PxGridService
-
-	c.log.Debug().Str("service", string(service)).Str("topic", string(topic)).Msg("Getting service by name for subscription")
-	svc, err = c.getServiceByName(sname)
+	s, err := c.subscribe(ctx, service, topic, sinks)
 	if err != nil {
 		return nil, err
 	}
 
-	c.log.Debug().Str("service", string(service)).Str("topic", string(topic)).Msg("Subscribing to topic")
-	rs, err := svc.On(string(topic)).Subscribe(ctx)
-	if err != nil {
+	c.storeSubscription(service, topic, s)
+	if err := c.storeUnsaved(ctx); err != nil {
+		c.log.Error().Err(err).Msg("Failed to store subscriptions")
+	}
+
+	return s, nil
+}
+
+func (c *Connection) subscribe(ctx context.Context, service ServiceName, topic TopicName, sinks []forwarder.Config) (*Subscription, error) {
+	s := c.newSubscription(service, topic)
+	if err := c.setSubscriptionSinks(s, sinks); err != nil {
+		return nil, err
+	}
+	if err := c.connectSubscription(ctx, s, ""); err != nil {
 		c.log.Error().Err(err).Msg("Failed to subscribe")
+		_ = s.close()
 		return nil, err
 	}
 	c.log.Info().Str("service", string(service)).Str("topic", string(topic)).Msg("Subscribed")
 
-	logger := c.log.With().Str("service", string(service)).Str("topic", string(topic)).Logger()
+	c.startSupervisor(s)
+
+	return s, nil
+}
 
-	s := &Subscription{
-		s:           rs,
-		Destination: rs.Destination(),
-		PubSub:      rs.PubSubService,
-		Service:     string(service),
-		Topic:       string(topic),
-		ps:          cnsm.PubSub(rs.PubSubService),
-		log:         &logger,
+// RestoreSubscriptions re-subscribes to every topic loaded from the database.
+// Failures are recorded in the connection logs and returned joined together.
+func (c *Connection) RestoreSubscriptions(ctx context.Context) error {
+	c.lock.Lock()
+	persisted := make(map[ServiceName]map[TopicName]*Subscription, len(c.topics))
+	for svc, topics := range c.topics {
+		for topic, s := range topics {
+			if s.supervised() {
+				continue
+			}
+			if persisted[svc] == nil {
+				persisted[svc] = make(map[TopicName]*Subscription)
+			}
+			persisted[svc][topic] = s
+		}
 	}
+	c.lock.Unlock()
 
-	c.startConsuming(topic, s)
+	if len(persisted) == 0 {
+		return nil
+	}
 
-	c.storeSubscription(service, topic, s)
+	c.log.Info().Int("services", len(persisted)).Msg("Restoring subscriptions")
+	if _, err := c.RebuildPxGridConsumer(); err != nil {
+		c.log.Error().Err(err).Msg("Failed to restore subscriptions")
+		return err
+	}
 
-	return s, nil
+	var errs []error
+	for svc, topics := range persisted {
+		for topic, old := range topics {
+			// failed subscriptions are kept and retried by the supervisor
+			s := c.newSubscription(svc, topic)
+			if err := c.setSubscriptionSinks(s, old.Sinks()); err != nil {
+				c.log.Error().Err(err).Str("service", string(svc)).Str("topic", string(topic)).
+					Msg("Failed to restore subscription sinks")
+			}
+			err := c.connectSubscription(ctx, s, "")
+			c.storeSubscription(svc, topic, s)
+			c.startSupervisor(s)
+
+			if err != nil {
+				c.log.Error().Err(err).Str("service", string(svc)).Str("topic", string(topic)).
+					Msg("Failed to restore subscription")
+				errs = append(errs, fmt.Errorf("failed to restore subscription %s/%s: %w", svc, topic, err))
+				continue
+			}
+
+			c.log.Info().Str("service", string(svc)).Str("topic", string(topic)).Msg("Subscription restored")
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
-func (c *Connection) Unsubscribe(sname string, topic TopicName) error {
+func (c *Connection) Unsubscribe(ctx context.Context, sname string, topic TopicName) error {
 	service, err := c.normalizeServiceName(sname)
 	if err != nil {
 		return err
 	}
 
 	s := c.FindSubscription(sname, topic)
-	if err := s.s.Unsubscribe(); err != nil {
+	if s == nil {
+		return ErrSubNotInitialized
+	}
+
+	if err := s.close(); err != nil {
 		c.log.Error().Err(err).Msg("Failed to unsubscribe")
 	}
 	c.log.Info().Str("service", string(service)).Str("topic", string(topic)).Msg("Unsubscribed")
 
 	c.lock.Lock()
 	delete(c.topics[service], topic)
+	if len(c.topics[service]) == 0 {
+		delete(c.topics, service)
+	}
+	c.unsaved[models.ClientColumns.Topics] = struct{}{}
 	c.lock.Unlock()
 
-	return nil
+	return c.storeUnsaved(ctx)
 }
 
 func (c *Connection) FindSubscription(sname string, topic TopicName) *Subscription {
@@ -138,10 +205,10 @@ func (c *Connection) AllSubscriptions() SubscriptionSlice {
 	return subs
 }
 
+// storeSubscription stores s as the subscription of the topic, the one it
+// replaces is closed so that its supervisor doesn't keep Stop waiting
 func (c *Connection) storeSubscription(service ServiceName, topic TopicName, s *Subscription) {
 	c.lock.Lock()
-	defer c.lock.Unlock()
-
 	if c.topics == nil {
 		c.topics = make(map[ServiceName]map[TopicName]*Subscription)
 	}
@@ -150,55 +217,34 @@ func (c *Connection) storeSubscription(service ServiceName, topic TopicName, s *
 		c.topics[service] = make(map[TopicName]*Subscription)
 	}
 
+	old := c.topics[service][topic]
 	c.topics[service][topic] = s
-}
-
-func (c *Connection) startConsuming(topic TopicName, s *Subscription) {
-	go func(dataChan <-chan *gopxgrid.Message[any], clid string) {
-		for data := range dataChan {
-			if data.Err != nil {
-				s.log.Error().Err(data.Err).Msg("Failed to read message")
-				continue
-			}
-			if data.UnmarshalError != nil {
-				s.log.Error().Err(data.UnmarshalError).Msg("Failed to unmarshal message")
-				continue
-			}
-
-			s.log.Debug().Str("topic", string(topic)).Msg("Received message")
-
-			m := models.Message{
-				Client:    clid,
-				Topic:     string(topic),
-				Viewed:    null.BoolFrom(false),
-				Timestamp: null.TimeFrom(time.Now()),
-			}
-			if data.Body != nil {
-				if err := m.Message.Marshal(data.Body); err != nil {
-					log.Error().Err(err).Msg("Failed to marshal message")
-					continue
-				}
-			} else {
-				m.Message.SetValid(data.Message.Body)
-			}
+	c.unsaved[models.ClientColumns.Topics] = struct{}{}
+	c.lock.Unlock()
 
-			db := c.db.Load()
-			if err := m.Insert(context.Background(), db, boil.Infer()); err != nil {
-				log.Error().Err(err).Msg("Failed to insert message")
-			}
-		}
-	}(s.s.C, c.id)
+	if old == nil || old == s {
+		return
+	}
+	if err := old.close(); err != nil {
+		c.log.Warn().Err(err).Str("service", string(service)).Str("topic", string(topic)).
+			Msg("Failed to unsubscribe replaced subscription")
+	}
 }
 
 func (s *Subscription) Nodes() ([]string, error) {
 	if s == nil {
 		return nil, ErrSubNotInitialized
 	}
-	if s.ps == nil {
+
+	s.lock.Lock()
+	ps := s.ps
+	s.lock.Unlock()
+
+	if ps == nil {
 		return nil, ErrPubSubServiceNotInitialized
 	}
 
-	oNodes := s.ps.Nodes()
+	oNodes := ps.Nodes()
 	nodes := make([]string, 0, len(oNodes))
 	for _, n := range oNodes {
 		nodes = append(nodes, n.NodeName)
@@ -210,11 +256,15 @@ func (s *Subscription) Nodes() ([]string, error) {
 // Subscription is a JSON Marshaller
 func (s *Subscription) MarshalJSON() ([]byte, error) {
 	nodes, err := s.Nodes()
-	if err != nil {
+	if err != nil && !errors.Is(err, ErrPubSubServiceNotInitialized) {
 		return nil, err
 	}
 
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	return json.Marshal(map[string]interface{}{
+		"sinks":       s.sinks,
 		"nodes":       nodes,
 		"pubsub":      s.PubSub,
 		"destination": s.Destination,
@@ -246,9 +296,24 @@ func (s *Subscription) UnmarshalJSON(data []byte) error {
 		s.Topic = v
 	}
 
+	var aux struct {
+		Sinks []forwarder.Config `json:"sinks"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	s.sinks = aux.Sinks
+
 	return nil
 }
 
+func (s *Subscription) Connected() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.s != nil && s.s.Active()
+}
+
 func (s *Subscription) ToProto() *pb.Subscription {
 	if s == nil {
 		return nil
@@ -259,19 +324,33 @@ func (s *Subscription) ToProto() *pb.Subscription {
 		s.log.Error().Err(err).Msg("Failed to get nodes")
 	}
 
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	connected := false
 	if s.s != nil {
 		connected = s.s.Active()
 	}
 
-	return &pb.Subscription{
-		Pubsub:      s.PubSub,
-		Destination: s.Destination,
-		Connected:   connected,
-		Nodes:       nodes,
-		Service:     s.Service,
-		Topic:       s.Topic,
+	p := &pb.Subscription{
+		Pubsub:            s.PubSub,
+		Destination:       s.Destination,
+		Connected:         connected,
+		Nodes:             nodes,
+		Service:           s.Service,
+		Topic:             s.Topic,
+		ReconnectAttempts: s.reconnectAttempts.Load(),
+		MessagesReceived:  s.messagesReceived.Load(),
+		Sinks:             forwarder.ConfigsToProto(s.sinks),
 	}
+	if !s.lastConnected.IsZero() {
+		p.LastConnected = timestamppb.New(s.lastConnected)
+	}
+	if s.lastError != nil {
+		p.LastError = s.lastError.Error()
+	}
+
+	return p
 }
 
 func (s SubscriptionSlice) ToProto() []*pb.Subscription {
@@ -0,0 +1,40 @@
+package connection
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStopAfterSubscribingTwice(t *testing.T) {
+	c := newTestConnection(t)
+
+	// the way Subscribe stores a subscription, without a reachable pxGrid the
+	// supervisors keep retrying until their subscription is closed
+	subscribe := func() *Subscription {
+		s := c.newSubscription("com.cisco.ise.session", "sessionTopic")
+		c.startSupervisor(s)
+		c.storeSubscription("com.cisco.ise.session", "sessionTopic", s)
+		return s
+	}
+	first := subscribe()
+	second := subscribe()
+
+	if !first.isClosed() {
+		t.Error("replaced subscription is not closed")
+	}
+	if second.isClosed() {
+		t.Error("current subscription is closed")
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		c.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop didn't return")
+	}
+}
@@ -0,0 +1,379 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	gopxgrid "github.com/vkumov/go-pxgrid"
+	"github.com/volatiletech/null/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
+	"github.com/vkumov/go-pxgrider/server/internal/tracing"
+)
+
+const (
+	reconnectMinBackoff = time.Second
+	reconnectMaxBackoff = 5 * time.Minute
+	reconnectTimeout    = 30 * time.Second
+	healthCheckInterval = 30 * time.Second
+	// drainTimeout bounds the draining of a dropped subscription, its channel
+	// isn't closed if the broker never completes the unsubscribe
+	drainTimeout = 30 * time.Second
+	// deliveryQueueSize bounds the messages of a subscription waiting for their
+	// insert, it's above the ingest batch size so that bursts are batched
+	deliveryQueueSize = 4096
+)
+
+// pendingMessage is a message queued for insert, inserted receives the result
+type pendingMessage struct {
+	ctx      context.Context
+	span     trace.Span
+	m        *models.Message
+	inserted chan error
+}
+
+var (
+	errSubscriptionDisconnected = errors.New("subscription disconnected")
+	errSubscriptionClosed       = errors.New("subscription closed")
+)
+
+func (c *Connection) newSubscription(service ServiceName, topic TopicName) *Subscription {
+	logger := c.log.With().Str("service", string(service)).Str("topic", string(topic)).Logger()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Subscription{
+		Service: string(service),
+		Topic:   string(topic),
+		log:     &logger,
+		done:    make(chan struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// connectSubscription subscribes to the topic of s, preferring the given pubsub node if set
+func (c *Connection) connectSubscription(ctx context.Context, s *Subscription, node string) error {
+	cnsm := c.pxCnsm.Load()
+	if cnsm == nil {
+		err := errors.New("pxgrid consumer is not initialized")
+		s.setError(err)
+		return err
+	}
+
+	s.log.Debug().Msg("Getting service by name for subscription")
+	svc, err := c.getServiceByName(s.Service)
+	if err != nil {
+		s.setError(err)
+		return err
+	}
+
+	s.log.Debug().Str("node", node).Msg("Subscribing to topic")
+	subscriber := svc.On(s.Topic)
+	if node != "" {
+		subscriber = subscriber.WithPubSubNodePicker(gopxgrid.PredicateNodePicker(func(n gopxgrid.ServiceNode) bool {
+			return n.NodeName == node
+		}))
+	}
+
+	rs, err := subscriber.Subscribe(ctx)
+	if err != nil {
+		s.setError(err)
+		return err
+	}
+
+	s.lock.Lock()
+	if s.closed() {
+		s.lock.Unlock()
+		_ = rs.Unsubscribe()
+		return errSubscriptionClosed
+	}
+
+	old := s.s
+	s.s = rs
+	s.ps = cnsm.PubSub(rs.PubSubService)
+	s.Destination = rs.Destination()
+	s.PubSub = rs.PubSubService
+	s.lastConnected = time.Now()
+	s.lastError = nil
+	s.lock.Unlock()
+
+	// the previous subscription may still be registered on the broker if it
+	// was dropped for being inactive
+	if old != nil && old != rs {
+		if err := old.Unsubscribe(); err != nil {
+			s.log.Debug().Err(err).Msg("Failed to unsubscribe previous subscription")
+		}
+	}
+
+	return nil
+}
+
+// startSupervisor runs supervise in the background, Stop waits for it to return
+func (c *Connection) startSupervisor(s *Subscription) {
+	c.supervisors.Add(1)
+	go func() {
+		defer c.supervisors.Done()
+		c.supervise(s)
+	}()
+}
+
+// supervise consumes messages of s and re-subscribes with backoff whenever
+// the subscription drops, until s is closed
+func (c *Connection) supervise(s *Subscription) {
+	failures := 0
+	for {
+		if sub := s.current(); sub != nil {
+			c.consume(s, sub)
+		}
+
+		if s.isClosed() {
+			return
+		}
+
+		s.lock.Lock()
+		if s.lastError == nil {
+			s.lastError = errSubscriptionDisconnected
+		}
+		s.lock.Unlock()
+
+		for {
+			delay := reconnectBackoff(failures)
+			s.log.Warn().Dur("delay", delay).Int("attempt", failures+1).Msg("Subscription is down, reconnecting")
+
+			select {
+			case <-s.done:
+				return
+			case <-time.After(delay):
+			}
+
+			s.reconnectAttempts.Add(1)
+			node := s.pickNode(failures)
+
+			ctx, cancel := context.WithTimeout(s.ctx, reconnectTimeout)
+			err := c.connectSubscription(ctx, s, node)
+			cancel()
+
+			if errors.Is(err, errSubscriptionClosed) {
+				return
+			}
+			if err != nil {
+				failures++
+				s.log.Error().Err(err).Str("node", node).Msg("Failed to reconnect subscription")
+				continue
+			}
+
+			s.log.Info().Str("node", node).Msg("Subscription reconnected")
+			failures = 0
+			break
+		}
+	}
+}
+
+// consume handles messages of sub until it stops delivering, becomes inactive
+// or s is closed, it returns once the handled messages are delivered
+func (c *Connection) consume(s *Subscription, sub *gopxgrid.Subscription[any]) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	pending := make(chan *pendingMessage, deliveryQueueSize)
+	delivered := make(chan struct{})
+	go func() {
+		defer close(delivered)
+		c.deliver(s, pending)
+	}()
+	defer func() {
+		close(pending)
+		<-delivered
+	}()
+
+	for {
+		select {
+		case <-s.done:
+			go drain(sub.C, drainTimeout)
+			return
+		case <-ticker.C:
+			if !sub.Active() {
+				s.log.Warn().Msg("Subscription is not active")
+				go drain(sub.C, drainTimeout)
+				return
+			}
+		case data, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			c.handleMessage(s, data, pending)
+		}
+	}
+}
+
+// handleMessage queues the message for insert, it's delivered to listeners and
+// sinks once inserted. It blocks while the ingest queue or pending are full.
+func (c *Connection) handleMessage(s *Subscription, data *gopxgrid.Message[any], pending chan<- *pendingMessage) {
+	if data.Err != nil {
+		s.log.Error().Err(data.Err).Msg("Failed to read message")
+		s.setError(data.Err)
+		return
+	}
+	if data.UnmarshalError != nil {
+		s.log.Error().Err(data.UnmarshalError).Msg("Failed to unmarshal message")
+		return
+	}
+
+	s.messagesReceived.Add(1)
+	metrics.MessagesReceived.WithLabelValues(c.id, s.Service, s.Topic).Inc()
+
+	ctx, span := tracing.Start(context.Background(), "pxgrid.message",
+		attribute.String("pxgrid.connection", c.id),
+		attribute.String("pxgrid.service", s.Service),
+		attribute.String("pxgrid.topic", s.Topic),
+	)
+
+	s.log.Debug().Ctx(ctx).Msg("Received message")
+
+	m := &models.Message{
+		Client:    c.id,
+		Topic:     s.Topic,
+		Viewed:    null.BoolFrom(false),
+		Timestamp: null.TimeFrom(time.Now()),
+		Service:   null.StringFrom(s.Service),
+	}
+	if data.Body != nil {
+		if err := m.Message.Marshal(data.Body); err != nil {
+			s.log.Error().Ctx(ctx).Err(err).Msg("Failed to marshal message")
+			tracing.End(span, err)
+			return
+		}
+	} else {
+		m.Message.SetValid(data.Message.Body)
+	}
+
+	p := &pendingMessage{ctx: ctx, span: span, m: m, inserted: make(chan error, 1)}
+	if err := c.ingest.InsertMessage(s.ctx, m, func(err error) { p.inserted <- err }); err != nil {
+		s.log.Error().Ctx(ctx).Err(err).Msg("Failed to queue message")
+		tracing.End(span, err)
+		return
+	}
+
+	pending <- p
+}
+
+// deliver publishes the pending messages to listeners and sinks in order, as
+// soon as they are inserted
+func (c *Connection) deliver(s *Subscription, pending <-chan *pendingMessage) {
+	for p := range pending {
+		err := <-p.inserted
+		if err != nil {
+			s.log.Error().Ctx(p.ctx).Err(err).Msg("Failed to insert message")
+		} else {
+			metrics.MessagesPersisted.WithLabelValues(c.id, s.Service, s.Topic).Inc()
+			c.hub.publish(s.Service, p.m)
+			c.forward(s, p.m)
+		}
+		tracing.End(p.span, err)
+	}
+}
+
+// drain discards messages of ch until it's closed or timeout passes, so that
+// the reader of an unsubscribed subscription doesn't block
+func drain[T any](ch <-chan T, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timer.C:
+			return
+		}
+	}
+}
+
+// reconnectBackoff returns an exponential delay with jitter for the given number of failures
+func reconnectBackoff(failures int) time.Duration {
+	d := reconnectMinBackoff << min(failures, 16)
+	if d <= 0 || d > reconnectMaxBackoff {
+		d = reconnectMaxBackoff
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+// pickNode rotates across the known pubsub nodes, empty if none are known yet
+func (s *Subscription) pickNode(failures int) string {
+	nodes, err := s.Nodes()
+	if err != nil || len(nodes) == 0 {
+		return ""
+	}
+
+	return nodes[failures%len(nodes)]
+}
+
+func (s *Subscription) current() *gopxgrid.Subscription[any] {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.s
+}
+
+func (s *Subscription) setError(err error) {
+	s.lock.Lock()
+	s.lastError = err
+	s.lock.Unlock()
+}
+
+// supervised reports whether s is being kept alive by a supervisor
+func (s *Subscription) supervised() bool {
+	return s.done != nil && !s.isClosed()
+}
+
+func (s *Subscription) isClosed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.closed()
+}
+
+// closed must be called with s.lock held
+func (s *Subscription) closed() bool {
+	if s.done == nil {
+		return false
+	}
+
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// close stops the supervisor and the forwarder and unsubscribes from the topic
+func (s *Subscription) close() error {
+	s.lock.Lock()
+	if s.done != nil && !s.closed() {
+		close(s.done)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+	fwd, sub := s.fwd, s.s
+	s.fwd, s.s = nil, nil
+	s.lock.Unlock()
+
+	// closing the forwarder flushes its queue, it must not block readers of s
+	fwd.Close()
+
+	if sub == nil {
+		return nil
+	}
+
+	return sub.Unsubscribe()
+}
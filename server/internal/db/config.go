@@ -2,6 +2,7 @@ package db
 
 import (
 	"errors"
+	"fmt"
 	"net"
 	"net/url"
 	"strconv"
@@ -9,7 +10,14 @@ import (
 )
 
 type (
+	// DBSpecs Driver is postgres or sqlite, Path is the database file of sqlite.
+	// AutoMigrate applies pending migrations when the server starts. It's off so
+	// that upgrades don't run DDL unattended, migrations such as the index build
+	// of 0002 lock tables for a while and are better applied with the migrate
+	// command during a maintenance window.
 	DBSpecs struct {
+		Driver                string        `default:"postgres"`
+		Path                  string        `default:"pxgrider.db"`
 		ConnRetry             int           `default:"1"`
 		ConnTimeout           time.Duration `default:"1m"`
 		SSLMode               string
@@ -18,11 +26,23 @@ type (
 		Host                  string
 		Port                  string
 		Name                  string
-		MaxParamsPerStatement int `default:"32767"`
+		MaxParamsPerStatement int  `default:"32767"`
+		AutoMigrate           bool `default:"false"`
 	}
 )
 
 func (cfg *DBSpecs) Validate() error {
+	switch cfg.Driver {
+	case DriverPostgres:
+	case DriverSQLite:
+		if cfg.Path == "" {
+			return errors.New("no DB path provided")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown DB driver %q, expected %s or %s", cfg.Driver, DriverPostgres, DriverSQLite)
+	}
+
 	if cfg.User == "" {
 		return errors.New("no DB user provided")
 	}
@@ -39,6 +59,10 @@ func (cfg *DBSpecs) Validate() error {
 }
 
 func (cfg DBSpecs) GetDSN() string { // nolint:gocritic
+	if cfg.Driver == DriverSQLite {
+		return sqliteDSN(cfg.Path)
+	}
+
 	query := make(url.Values)
 	if cfg.SSLMode != "" {
 		query.Set("sslmode", cfg.SSLMode)
@@ -3,36 +3,69 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"sync/atomic"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/XSAM/otelsql"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
 )
 
 var logger atomic.Pointer[zerolog.Logger]
 
-func NewSQL(ctx context.Context, cfg DBSpecs, l *zerolog.Logger) (*sql.DB, error) {
+// NewSQL opens the configured database, queries relying on features of one
+// database go through the returned dialect
+func NewSQL(ctx context.Context, cfg DBSpecs, l *zerolog.Logger) (*sql.DB, Dialect, error) {
 	if l != nil {
 		logger.Store(l)
 	} else {
 		logger.Store(&log.Logger)
 	}
 
-	db, err := sql.Open("postgres", cfg.GetDSN())
+	dialect, err := NewDialect(cfg.Driver)
 	if err != nil {
-		return nil, fmt.Errorf("create db conn: %w", err)
+		return nil, nil, err
+	}
+
+	driverName, system := postgresDriverName, semconv.DBSystemPostgreSQL
+	if cfg.Driver == DriverSQLite {
+		driverName, system = sqliteDriverName, semconv.DBSystemSqlite
+	}
+
+	db, err := otelsql.Open(driverName, cfg.GetDSN(),
+		otelsql.WithAttributes(system),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitRows:             true,
+			SpanFilter:           withParentSpan,
+		}),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create db conn: %w", err)
+	}
+
+	if cfg.Driver == DriverSQLite {
+		// SQLite has a single writer, sharing one connection avoids busy errors
+		db.SetMaxOpenConns(1)
 	}
 
 	if err = PingConnection(ctx, &cfg, func(pingCtx context.Context) error {
 		return db.PingContext(pingCtx)
 	}); err != nil {
-		return nil, fmt.Errorf("create db conn: %w", err)
+		return nil, nil, fmt.Errorf("create db conn: %w", err)
 	}
 
-	return db, nil
+	return db, dialect, nil
 }
 
 func PingConnection(ctx context.Context, cfg *DBSpecs, pinger func(ctx context.Context) error) error {
@@ -60,3 +93,9 @@ func PingConnection(ctx context.Context, cfg *DBSpecs, pinger func(ctx context.C
 	}
 	return fmt.Errorf("ping database connection: %w", err)
 }
+
+// withParentSpan traces only the queries made within a traced operation, so that
+// background writes like connection logs don't start traces of their own
+func withParentSpan(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Dialect holds what differs between the supported databases, the one of the
+// configured driver is chosen by NewSQL
+type Dialect interface {
+	// Name is the configured driver, DriverPostgres or DriverSQLite
+	Name() string
+	// MigrationsDir is the directory of the embedded migrations
+	MigrationsDir() string
+	// CreateMigrationsTable creates the schema_migrations table if it's missing
+	CreateMigrationsTable() string
+	// MigrationsTableExists reports whether the schema_migrations table exists
+	MigrationsTableExists() string
+	// LockMigrations keeps other instances from migrating until unlock is called
+	LockMigrations(ctx context.Context, conn *sql.Conn) (unlock func(context.Context) error, err error)
+	// ReserveIDs returns n unused ascending IDs of the table, rows inserted with
+	// them don't collide with the ones taking the default ID
+	ReserveIDs(ctx context.Context, exec boil.ContextExecutor, table string, n int) ([]int64, error)
+
+	// EstimateRows returns the number of rows the query yields, estimated by the
+	// planner if the database has a cheap estimate, counted otherwise
+	EstimateRows(ctx context.Context, exec boil.ContextExecutor, query string, args ...any) (int64, error)
+	// ForUpdate locks the selected rows until the end of the transaction, if the
+	// database locks rows at all
+	ForUpdate() []qm.QueryMod
+	// JSONFieldEquals matches rows where the text at path of the JSON column is value
+	JSONFieldEquals(column string, path []string, value string) qm.QueryMod
+	// JSONContains matches rows where the JSON column contains the document like
+	// the jsonb @> operator, false if the database can't evaluate it
+	JSONContains(column, doc string) (qm.QueryMod, bool)
+}
+
+// NewDialect returns the dialect of the driver
+func NewDialect(driver string) (Dialect, error) {
+	switch driver {
+	case DriverPostgres:
+		return postgres{}, nil
+	case DriverSQLite:
+		return sqlite{}, nil
+	default:
+		return nil, fmt.Errorf("unknown DB driver %q, expected %s or %s", driver, DriverPostgres, DriverSQLite)
+	}
+}
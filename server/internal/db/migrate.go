@@ -0,0 +1,258 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations
+var migrationsFS embed.FS
+
+type (
+	// Migration is a pair of <version>_<name>.up.sql and .down.sql files
+	Migration struct {
+		Version int64
+		Name    string
+		Up      string
+		Down    string
+	}
+
+	// MigrationStatus reports whether a migration is applied, AppliedAt is zero if not
+	MigrationStatus struct {
+		Migration
+		AppliedAt time.Time
+	}
+
+	queryer interface {
+		QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	}
+)
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Migrations returns the embedded migrations of the dialect ordered by version
+func Migrations(d Dialect) ([]Migration, error) {
+	dir := d.MigrationsDir()
+	entries, err := fs.ReadDir(migrationsFS, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		base, direction, ok := strings.Cut(strings.TrimSuffix(e.Name(), ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration %s: expected <version>_<name>.up.sql or .down.sql", e.Name())
+		}
+		v, name, _ := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: invalid version: %w", e.Name(), err)
+		}
+
+		data, err := fs.ReadFile(migrationsFS, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if direction == "up" {
+			m.Up = string(data)
+		} else {
+			m.Down = string(data)
+		}
+	}
+
+	res := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %s: missing up file", m)
+		}
+		res = append(res, *m)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Version < res[j].Version })
+
+	return res, nil
+}
+
+// MigrateUp applies every pending migration and returns the applied ones
+func MigrateUp(ctx context.Context, db *sql.DB, d Dialect) ([]Migration, error) {
+	var applied []Migration
+	err := withMigrationsLock(ctx, db, d, func(conn *sql.Conn, done map[int64]time.Time) error {
+		all, err := Migrations(d)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range all {
+			if _, ok := done[m.Version]; ok {
+				continue
+			}
+
+			logger.Load().Info().Stringer("migration", m).Msg("apply migration")
+			if err := runMigration(ctx, conn, m.Up,
+				"INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", m.Version, m.Name); err != nil {
+				return fmt.Errorf("apply migration %s: %w", m, err)
+			}
+			applied = append(applied, m)
+		}
+		return nil
+	})
+
+	return applied, err
+}
+
+// MigrateDown reverts the last applied migrations, steps of them, and returns
+// the reverted ones
+func MigrateDown(ctx context.Context, db *sql.DB, d Dialect, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := withMigrationsLock(ctx, db, d, func(conn *sql.Conn, done map[int64]time.Time) error {
+		all, err := Migrations(d)
+		if err != nil {
+			return err
+		}
+
+		for i := len(all) - 1; i >= 0 && len(reverted) < steps; i-- {
+			m := all[i]
+			if _, ok := done[m.Version]; !ok {
+				continue
+			}
+			if m.Down == "" {
+				return fmt.Errorf("revert migration %s: missing down file", m)
+			}
+
+			logger.Load().Info().Stringer("migration", m).Msg("revert migration")
+			if err := runMigration(ctx, conn, m.Down,
+				"DELETE FROM schema_migrations WHERE version = $1", m.Version); err != nil {
+				return fmt.Errorf("revert migration %s: %w", m, err)
+			}
+			reverted = append(reverted, m)
+		}
+		return nil
+	})
+
+	return reverted, err
+}
+
+// MigrateStatus returns every embedded migration with the time it was applied.
+// It only reads the schema, so it neither waits for a running migration nor
+// creates the schema_migrations table.
+func MigrateStatus(ctx context.Context, db *sql.DB, d Dialect) ([]MigrationStatus, error) {
+	all, err := Migrations(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, d.MigrationsTableExists()).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("look up schema_migrations: %w", err)
+	}
+
+	done := make(map[int64]time.Time)
+	if exists {
+		if done, err = appliedMigrations(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]MigrationStatus, 0, len(all))
+	for _, m := range all {
+		res = append(res, MigrationStatus{Migration: m, AppliedAt: done[m.Version]})
+	}
+
+	return res, nil
+}
+
+// withMigrationsLock runs fn on a connection holding the migrations lock of the
+// dialect, with the versions of applied migrations
+func withMigrationsLock(ctx context.Context, db *sql.DB, d Dialect, fn func(conn *sql.Conn, done map[int64]time.Time) error) (err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close()
+
+	unlock, err := d.LockMigrations(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("acquire migrations lock: %w", err)
+	}
+	defer func() {
+		// the lock may be held by the session, the connection is discarded instead
+		// of returned to the pool if unlocking fails
+		if unlockErr := unlock(context.WithoutCancel(ctx)); unlockErr != nil {
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+			err = errors.Join(err, fmt.Errorf("release migrations lock: %w", unlockErr))
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, d.CreateMigrationsTable()); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	done, err := appliedMigrations(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	return fn(conn, done)
+}
+
+// appliedMigrations returns the versions of applied migrations with the time they were applied
+func appliedMigrations(ctx context.Context, q queryer) (map[int64]time.Time, error) {
+	rows, err := q.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("load applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	done := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			v int64
+			t time.Time
+		)
+		if err := rows.Scan(&v, &t); err != nil {
+			return nil, fmt.Errorf("load applied migrations: %w", err)
+		}
+		done[v] = t
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("load applied migrations: %w", err)
+	}
+
+	return done, nil
+}
+
+// runMigration executes the script and records it in the same transaction
+func runMigration(ctx context.Context, conn *sql.Conn, script, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
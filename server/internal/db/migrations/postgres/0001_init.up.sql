@@ -0,0 +1,54 @@
+-- Tables of the generated models. They may already exist in databases
+-- created by hand, so everything is created only when missing.
+
+CREATE TABLE IF NOT EXISTS clients (
+	id text PRIMARY KEY,
+	"friendlyName" text,
+	"primary" json,
+	credentials json,
+	services json,
+	attributes json,
+	secondaries json,
+	owner text NOT NULL DEFAULT '',
+	"clientName" text,
+	topics json
+);
+
+CREATE TABLE IF NOT EXISTS users (
+	"user" text NOT NULL,
+	clid text NOT NULL,
+	PRIMARY KEY ("user", clid)
+);
+
+CREATE TABLE IF NOT EXISTS logs (
+	id bigserial PRIMARY KEY,
+	client text NOT NULL,
+	level text NOT NULL,
+	"timestamp" timestamptz DEFAULT now(),
+	message text,
+	label text
+);
+
+CREATE TABLE IF NOT EXISTS messages (
+	id bigserial PRIMARY KEY,
+	client text NOT NULL,
+	topic text NOT NULL,
+	message json,
+	"timestamp" timestamptz DEFAULT now(),
+	viewed boolean DEFAULT false
+);
+
+-- NOT VALID skips the check of existing rows, rows of connections deleted
+-- before the constraints existed would fail it
+DO $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'logs_client_fkey') THEN
+		ALTER TABLE logs ADD CONSTRAINT logs_client_fkey
+			FOREIGN KEY (client) REFERENCES clients (id) ON DELETE CASCADE NOT VALID;
+	END IF;
+	IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'messages_client_fkey') THEN
+		ALTER TABLE messages ADD CONSTRAINT messages_client_fkey
+			FOREIGN KEY (client) REFERENCES clients (id) ON DELETE CASCADE NOT VALID;
+	END IF;
+END
+$$;
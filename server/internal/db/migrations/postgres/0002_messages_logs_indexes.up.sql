@@ -0,0 +1,10 @@
+-- Indexes backing the pagination, filters and retention of messages and logs
+
+CREATE INDEX IF NOT EXISTS clients_owner_idx ON clients (owner);
+CREATE INDEX IF NOT EXISTS messages_client_id_idx ON messages (client, id);
+CREATE INDEX IF NOT EXISTS messages_client_topic_id_idx ON messages (client, topic, id);
+CREATE INDEX IF NOT EXISTS messages_client_timestamp_idx ON messages (client, "timestamp");
+CREATE INDEX IF NOT EXISTS messages_client_viewed_idx ON messages (client, viewed);
+CREATE INDEX IF NOT EXISTS messages_message_gin_idx ON messages USING gin ((message::jsonb) jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS logs_client_id_idx ON logs (client, id);
+CREATE INDEX IF NOT EXISTS logs_client_timestamp_idx ON logs (client, "timestamp");
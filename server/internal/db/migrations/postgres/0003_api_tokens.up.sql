@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS api_tokens (
+	id text PRIMARY KEY,
+	uid text NOT NULL,
+	name text NOT NULL DEFAULT '',
+	role text NOT NULL,
+	token_hash text NOT NULL UNIQUE,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	last_used_at timestamptz,
+	expires_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS api_tokens_uid_idx ON api_tokens (uid);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS logs;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS clients;
@@ -0,0 +1,38 @@
+-- Tables of the generated models, JSON documents are stored as text
+
+CREATE TABLE IF NOT EXISTS clients (
+	id text PRIMARY KEY,
+	"friendlyName" text,
+	"primary" text,
+	credentials text,
+	services text,
+	attributes text,
+	secondaries text,
+	owner text NOT NULL DEFAULT '',
+	"clientName" text,
+	topics text
+);
+
+CREATE TABLE IF NOT EXISTS users (
+	"user" text NOT NULL,
+	clid text NOT NULL,
+	PRIMARY KEY ("user", clid)
+);
+
+CREATE TABLE IF NOT EXISTS logs (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	client text NOT NULL REFERENCES clients (id) ON DELETE CASCADE,
+	level text NOT NULL,
+	"timestamp" timestamp DEFAULT CURRENT_TIMESTAMP,
+	message text,
+	label text
+);
+
+CREATE TABLE IF NOT EXISTS messages (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	client text NOT NULL REFERENCES clients (id) ON DELETE CASCADE,
+	topic text NOT NULL,
+	message text,
+	"timestamp" timestamp DEFAULT CURRENT_TIMESTAMP,
+	viewed boolean DEFAULT false
+);
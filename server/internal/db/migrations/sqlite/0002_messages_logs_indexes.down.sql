@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS logs_client_timestamp_idx;
+DROP INDEX IF EXISTS logs_client_id_idx;
+DROP INDEX IF EXISTS messages_client_viewed_idx;
+DROP INDEX IF EXISTS messages_client_timestamp_idx;
+DROP INDEX IF EXISTS messages_client_topic_id_idx;
+DROP INDEX IF EXISTS messages_client_id_idx;
+DROP INDEX IF EXISTS clients_owner_idx;
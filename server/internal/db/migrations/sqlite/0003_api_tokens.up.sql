@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS api_tokens (
+	id text PRIMARY KEY,
+	uid text NOT NULL,
+	name text NOT NULL DEFAULT '',
+	role text NOT NULL,
+	token_hash text NOT NULL UNIQUE,
+	created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	last_used_at timestamp,
+	expires_at timestamp
+);
+
+CREATE INDEX IF NOT EXISTS api_tokens_uid_idx ON api_tokens (uid);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	actor text NOT NULL,
+	token_id text NOT NULL DEFAULT '',
+	uid text NOT NULL DEFAULT '',
+	method text NOT NULL,
+	connection_id text NOT NULL DEFAULT '',
+	peer text NOT NULL DEFAULT '',
+	code text NOT NULL,
+	error text NOT NULL DEFAULT '',
+	details text
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_uid_id_idx ON audit_events (uid, id);
+CREATE INDEX IF NOT EXISTS audit_events_connection_id_id_idx ON audit_events (connection_id, id);
@@ -24,12 +24,13 @@ import (
 
 // Message is an object representing the database table.
 type Message struct {
-	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Client    string    `boil:"client" json:"client" toml:"client" yaml:"client"`
-	Topic     string    `boil:"topic" json:"topic" toml:"topic" yaml:"topic"`
-	Message   null.JSON `boil:"message" json:"message,omitempty" toml:"message" yaml:"message,omitempty"`
-	Timestamp null.Time `boil:"timestamp" json:"timestamp,omitempty" toml:"timestamp" yaml:"timestamp,omitempty"`
-	Viewed    null.Bool `boil:"viewed" json:"viewed,omitempty" toml:"viewed" yaml:"viewed,omitempty"`
+	ID        int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Client    string      `boil:"client" json:"client" toml:"client" yaml:"client"`
+	Topic     string      `boil:"topic" json:"topic" toml:"topic" yaml:"topic"`
+	Message   null.JSON   `boil:"message" json:"message,omitempty" toml:"message" yaml:"message,omitempty"`
+	Timestamp null.Time   `boil:"timestamp" json:"timestamp,omitempty" toml:"timestamp" yaml:"timestamp,omitempty"`
+	Viewed    null.Bool   `boil:"viewed" json:"viewed,omitempty" toml:"viewed" yaml:"viewed,omitempty"`
+	Service   null.String `boil:"service" json:"service,omitempty" toml:"service" yaml:"service,omitempty"`
 
 	R *messageR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L messageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +43,7 @@ var MessageColumns = struct {
 	Message   string
 	Timestamp string
 	Viewed    string
+	Service   string
 }{
 	ID:        "id",
 	Client:    "client",
@@ -49,6 +51,7 @@ var MessageColumns = struct {
 	Message:   "message",
 	Timestamp: "timestamp",
 	Viewed:    "viewed",
+	Service:   "service",
 }
 
 var MessageTableColumns = struct {
@@ -58,6 +61,7 @@ var MessageTableColumns = struct {
 	Message   string
 	Timestamp string
 	Viewed    string
+	Service   string
 }{
 	ID:        "messages.id",
 	Client:    "messages.client",
@@ -65,6 +69,7 @@ var MessageTableColumns = struct {
 	Message:   "messages.message",
 	Timestamp: "messages.timestamp",
 	Viewed:    "messages.viewed",
+	Service:   "messages.service",
 }
 
 // Generated where
@@ -100,6 +105,7 @@ var MessageWhere = struct {
 	Message   whereHelpernull_JSON
 	Timestamp whereHelpernull_Time
 	Viewed    whereHelpernull_Bool
+	Service   whereHelpernull_String
 }{
 	ID:        whereHelperint64{field: "\"messages\".\"id\""},
 	Client:    whereHelperstring{field: "\"messages\".\"client\""},
@@ -107,6 +113,7 @@ var MessageWhere = struct {
 	Message:   whereHelpernull_JSON{field: "\"messages\".\"message\""},
 	Timestamp: whereHelpernull_Time{field: "\"messages\".\"timestamp\""},
 	Viewed:    whereHelpernull_Bool{field: "\"messages\".\"viewed\""},
+	Service:   whereHelpernull_String{field: "\"messages\".\"service\""},
 }
 
 // MessageRels is where relationship names are stored.
@@ -137,9 +144,9 @@ func (r *messageR) GetMessageClient() *Client {
 type messageL struct{}
 
 var (
-	messageAllColumns            = []string{"id", "client", "topic", "message", "timestamp", "viewed"}
+	messageAllColumns            = []string{"id", "client", "topic", "message", "timestamp", "viewed", "service"}
 	messageColumnsWithoutDefault = []string{"client", "topic"}
-	messageColumnsWithDefault    = []string{"id", "message", "timestamp", "viewed"}
+	messageColumnsWithDefault    = []string{"id", "message", "timestamp", "viewed", "service"}
 	messagePrimaryKeyColumns     = []string{"id"}
 	messageGeneratedColumns      = []string{}
 )
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	postgresDriverName = "postgres"
+
+	// migrationsLockKey is the advisory lock held while migrating, so that
+	// replicas starting together don't apply the same migration twice
+	migrationsLockKey int64 = 0x7078677269646572 // "pxgrider"
+)
+
+type postgres struct{}
+
+var _ Dialect = postgres{}
+
+func (postgres) Name() string {
+	return DriverPostgres
+}
+
+func (postgres) MigrationsDir() string {
+	return "migrations/postgres"
+}
+
+func (postgres) CreateMigrationsTable() string {
+	return `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name text NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`
+}
+
+func (postgres) MigrationsTableExists() string {
+	return `SELECT to_regclass('schema_migrations') IS NOT NULL`
+}
+
+// LockMigrations takes the advisory lock, which is held by the session of the connection
+func (postgres) LockMigrations(ctx context.Context, conn *sql.Conn) (func(context.Context) error, error) {
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockKey); err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) error {
+		_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationsLockKey)
+		return err
+	}, nil
+}
+
+// ReserveIDs takes the IDs from the sequence of the id column, they may have
+// gaps when other transactions reserve IDs at the same time
+func (postgres) ReserveIDs(ctx context.Context, exec boil.ContextExecutor, table string, n int) ([]int64, error) {
+	rows, err := exec.QueryContext(ctx,
+		"SELECT nextval(pg_get_serial_sequence($1, 'id')) FROM generate_series(1, $2)", table, n)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0, n)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) != n {
+		return nil, fmt.Errorf("reserved %d IDs of %s, expected %d", len(ids), table, n)
+	}
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// EstimateRows returns the planner estimate, which is cheap but can be off
+// until the table is analyzed
+func (postgres) EstimateRows(ctx context.Context, exec boil.ContextExecutor, query string, args ...any) (int64, error) {
+	var raw []byte
+	if err := exec.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&raw); err != nil {
+		return 0, err
+	}
+
+	var plan []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(raw, &plan); err != nil {
+		return 0, err
+	}
+	if len(plan) == 0 {
+		return 0, errors.New("empty query plan")
+	}
+
+	return int64(plan[0].Plan.Rows), nil
+}
+
+func (postgres) ForUpdate() []qm.QueryMod {
+	return []qm.QueryMod{qm.For("UPDATE")}
+}
+
+// JSONFieldEquals narrows the rows by containment, which the GIN index of the
+// column serves, and compares the text at path for the exact match
+func (postgres) JSONFieldEquals(column string, path []string, value string) qm.QueryMod {
+	exact := qm.Where(column+" #>> ?::text[] = ?", pq.Array(path), value)
+
+	docs := containmentDocs(path, value)
+	if len(docs) == 0 {
+		return exact
+	}
+
+	contains := make([]qm.QueryMod, 0, len(docs))
+	for i, doc := range docs {
+		if i == 0 {
+			contains = append(contains, qm.Where(column+"::jsonb @> ?::jsonb", doc))
+		} else {
+			contains = append(contains, qm.Or(column+"::jsonb @> ?::jsonb", doc))
+		}
+	}
+
+	return qm.Expr(qm.Expr(contains...), exact)
+}
+
+// containmentDocs returns the documents holding value at path, as a string and
+// as the number or boolean it may denote since the text of those matches too.
+// Numeric segments may be array indexes, which containment can't express.
+func containmentDocs(path []string, value string) []string {
+	for _, p := range path {
+		if _, err := strconv.Atoi(p); err == nil {
+			return nil
+		}
+	}
+
+	str, _ := json.Marshal(value)
+	leaves := []json.RawMessage{str}
+
+	var v any
+	if err := json.Unmarshal([]byte(value), &v); err == nil {
+		switch v.(type) {
+		case float64, bool:
+			leaves = append(leaves, json.RawMessage(value))
+		}
+	}
+
+	docs := make([]string, 0, len(leaves))
+	for _, leaf := range leaves {
+		doc := any(leaf)
+		for i := len(path) - 1; i >= 0; i-- {
+			doc = map[string]any{path[i]: doc}
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil
+		}
+		docs = append(docs, string(data))
+	}
+
+	return docs
+}
+
+func (postgres) JSONContains(column, doc string) (qm.QueryMod, bool) {
+	return qm.Where(column+"::jsonb @> ?::jsonb", doc), true
+}
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestContainmentDocs(t *testing.T) {
+	tests := []struct {
+		path  []string
+		value string
+		want  []string
+	}{
+		{[]string{"user", "name"}, "alice", []string{`{"user":{"name":"alice"}}`}},
+		{[]string{"port"}, "8910", []string{`{"port":"8910"}`, `{"port":8910}`}},
+		{[]string{"active"}, "true", []string{`{"active":"true"}`, `{"active":true}`}},
+		{[]string{"state"}, "null", []string{`{"state":"null"}`}},
+		{[]string{"sessions", "0", "state"}, "STARTED", nil},
+	}
+
+	for _, tt := range tests {
+		if got := containmentDocs(tt.path, tt.value); !slices.Equal(got, tt.want) {
+			t.Errorf("containmentDocs(%v, %q) = %v, want %v", tt.path, tt.value, got, tt.want)
+		}
+	}
+}
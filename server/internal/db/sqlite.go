@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	_ "modernc.org/sqlite"
+)
+
+// sqliteDriverName is the pure Go driver, builds don't need cgo
+const sqliteDriverName = "sqlite"
+
+type sqlite struct{}
+
+var _ Dialect = sqlite{}
+
+// sqliteDSN enables foreign keys, which SQLite ignores by default, and WAL so
+// that readers don't wait for writers. Times are written in the format of the
+// SQLite date functions so that they compare as text.
+func sqliteDSN(path string) string {
+	query := make(url.Values)
+	query.Add("_pragma", "busy_timeout(5000)")
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Set("_time_format", "sqlite")
+
+	return "file:" + path + "?" + query.Encode()
+}
+
+func (sqlite) Name() string {
+	return DriverSQLite
+}
+
+func (sqlite) MigrationsDir() string {
+	return "migrations/sqlite"
+}
+
+func (sqlite) CreateMigrationsTable() string {
+	return `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version integer PRIMARY KEY,
+		name text NOT NULL,
+		applied_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`
+}
+
+func (sqlite) MigrationsTableExists() string {
+	return `SELECT count(*) > 0 FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'`
+}
+
+// LockMigrations locks nothing, SQLite has no advisory locks and its single
+// connection serializes migrations of the process
+func (sqlite) LockMigrations(context.Context, *sql.Conn) (func(context.Context) error, error) {
+	return func(context.Context) error { return nil }, nil
+}
+
+// ReserveIDs continues after the highest ID the table ever had. It must be
+// called in the transaction inserting the rows, SQLite serializes writers so
+// no other insert can take them meanwhile.
+func (sqlite) ReserveIDs(ctx context.Context, exec boil.ContextExecutor, table string, n int) ([]int64, error) {
+	var last int64
+	err := exec.QueryRowContext(ctx, `SELECT max(
+		coalesce((SELECT seq FROM sqlite_sequence WHERE name = ?), 0),
+		coalesce((SELECT max(id) FROM "`+table+`"), 0))`, table).Scan(&last)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, n)
+	for i := range ids {
+		ids[i] = last + int64(i) + 1
+	}
+
+	return ids, nil
+}
+
+// EstimateRows counts the rows, SQLite has no planner estimate
+func (sqlite) EstimateRows(ctx context.Context, exec boil.ContextExecutor, query string, args ...any) (int64, error) {
+	var n int64
+	err := exec.QueryRowContext(ctx, "SELECT count(*) FROM ("+strings.TrimSuffix(query, ";")+")", args...).Scan(&n)
+	return n, err
+}
+
+// ForUpdate locks nothing, SQLite locks the whole database for the write of the transaction
+func (sqlite) ForUpdate() []qm.QueryMod {
+	return nil
+}
+
+func (sqlite) JSONFieldEquals(column string, path []string, value string) qm.QueryMod {
+	return qm.Where("CAST(json_extract("+column+", ?) AS TEXT) = ?", sqlitePath(path), value)
+}
+
+// JSONContains isn't supported, SQLite has no JSON containment operator
+func (sqlite) JSONContains(string, string) (qm.QueryMod, bool) {
+	return nil, false
+}
+
+// sqlitePath converts a field path to a JSON path of json_extract
+func sqlitePath(path []string) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, p := range path {
+		if _, err := strconv.Atoi(p); err == nil {
+			b.WriteString("[" + p + "]")
+			continue
+		}
+		b.WriteString(`."` + strings.ReplaceAll(p, `"`, `\"`) + `"`)
+	}
+	return b.String()
+}
@@ -0,0 +1,274 @@
+package forwarder
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type (
+	// Event is a stored pxGrid message handed to the sinks
+	Event struct {
+		ConnectionID string          `json:"connection_id"`
+		Service      string          `json:"service"`
+		Topic        string          `json:"topic"`
+		MessageID    int64           `json:"message_id"`
+		Timestamp    time.Time       `json:"timestamp"`
+		Message      json.RawMessage `json:"message"`
+	}
+
+	// Sink delivers events to an external system, Send is called from a single goroutine
+	Sink interface {
+		Send(ctx context.Context, e Event) error
+		Close() error
+	}
+
+	// Config describes a sink, it is persisted with the subscription or the connection
+	Config struct {
+		Name    string         `json:"name,omitempty"`
+		Type    string         `json:"type"`
+		Webhook *WebhookConfig `json:"webhook,omitempty"`
+		Syslog  *SyslogConfig  `json:"syslog,omitempty"`
+		NATS    *NATSConfig    `json:"nats,omitempty"`
+		Kafka   *KafkaConfig   `json:"kafka,omitempty"`
+		Retry   RetryConfig    `json:"retry,omitempty"`
+		// Block holds back the subscription while the sink is failing or behind
+		// instead of queueing events on disk, where the oldest ones are dropped when full
+		Block bool `json:"block,omitempty"`
+	}
+
+	RetryConfig struct {
+		MaxAttempts int           `json:"max_attempts,omitempty"`
+		Backoff     time.Duration `json:"backoff,omitempty"`
+	}
+
+	Options struct {
+		// QueueDir keeps events which could not be delivered, it's required to
+		// start sinks
+		QueueDir      string
+		QueueMaxItems int
+		// RetryInterval is how often queued events are retried
+		RetryInterval time.Duration
+	}
+
+	// Forwarder delivers events to the sinks of a subscription or a connection
+	// without blocking the consumer
+	Forwarder struct {
+		sinks []*queuedSink
+	}
+)
+
+var ErrNoQueueDir = errors.New("forwarder queue dir is not configured")
+
+const (
+	TypeWebhook = "webhook"
+	TypeSyslog  = "syslog"
+	TypeNATS    = "nats"
+	TypeKafka   = "kafka"
+
+	defaultMaxAttempts = 3
+	defaultBackoff     = time.Second
+)
+
+var options atomic.Pointer[Options]
+
+func init() {
+	options.Store(&Options{
+		QueueMaxItems: 10000,
+		RetryInterval: 30 * time.Second,
+	})
+}
+
+// Configure sets options of forwarders created from now on
+func Configure(o Options) {
+	options.Store(&o)
+}
+
+func (c Config) Validate() error {
+	switch c.Type {
+	case TypeWebhook:
+		if c.Webhook == nil {
+			return errors.New("webhook sink requires webhook settings")
+		}
+		return c.Webhook.Validate()
+	case TypeSyslog:
+		if c.Syslog == nil {
+			return errors.New("syslog sink requires syslog settings")
+		}
+		return c.Syslog.Validate()
+	case TypeNATS:
+		if c.NATS == nil {
+			return errors.New("nats sink requires nats settings")
+		}
+		return c.NATS.Validate()
+	case TypeKafka:
+		if c.Kafka == nil {
+			return errors.New("kafka sink requires kafka settings")
+		}
+		return c.Kafka.Validate()
+	default:
+		return fmt.Errorf("unknown sink type %q", c.Type)
+	}
+}
+
+func (c Config) newSink() (Sink, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch c.Type {
+	case TypeWebhook:
+		return newWebhookSink(*c.Webhook), nil
+	case TypeSyslog:
+		return newSyslogSink(*c.Syslog), nil
+	case TypeNATS:
+		return newNATSSink(*c.NATS), nil
+	case TypeKafka:
+		return newKafkaSink(*c.Kafka), nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", c.Type)
+	}
+}
+
+func (c Config) displayName(i int) string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return fmt.Sprintf("%s-%d", c.Type, i)
+}
+
+// queueKey identifies the on-disk queue of the sink among the sinks of a
+// forwarder, it's the name if set and the destination otherwise so that
+// reordering the sinks doesn't swap their queues
+func (c Config) queueKey() string {
+	if c.Name != "" {
+		return c.Name
+	}
+
+	var dest string
+	switch c.Type {
+	case TypeWebhook:
+		dest = c.Webhook.URL
+	case TypeSyslog:
+		dest = c.Syslog.Network + "://" + c.Syslog.Address
+	case TypeNATS:
+		dest = c.NATS.URL + " " + c.NATS.Subject
+	case TypeKafka:
+		dest = strings.Join(c.Kafka.Brokers, ",") + " " + c.Kafka.Topic
+	}
+
+	return c.Type + ":" + dest
+}
+
+// queueDirs returns the on-disk queue dir of every sink, unnamed sinks with the
+// same destination are told apart by their order
+func queueDirs(root, id string, cfgs []Config) []string {
+	dirs := make([]string, len(cfgs))
+	seen := make(map[string]int, len(cfgs))
+	for i, cfg := range cfgs {
+		key := cfg.queueKey()
+		if n := seen[key]; n > 0 {
+			key = fmt.Sprintf("%s#%d", key, n)
+		}
+		seen[cfg.queueKey()]++
+
+		sum := sha1.Sum([]byte(id + "/" + key))
+		dirs[i] = filepath.Join(root, hex.EncodeToString(sum[:]))
+	}
+
+	return dirs
+}
+
+// adoptLegacyQueue moves the queue an unnamed sink had when queues were keyed
+// by the position of the sink, unless the sink already has a queue
+func adoptLegacyQueue(root, id string, cfg Config, i int, dir string) error {
+	if cfg.Name != "" {
+		return nil
+	}
+	if _, err := os.Stat(dir); !errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	sum := sha1.Sum([]byte(id + "/" + cfg.displayName(i)))
+	legacy := filepath.Join(root, hex.EncodeToString(sum[:]))
+	if _, err := os.Stat(legacy); err != nil {
+		return nil
+	}
+
+	return os.Rename(legacy, dir)
+}
+
+// New starts a forwarder for the given sinks, id identifies the subscription
+// or connection and keeps its on-disk queues apart from others
+func New(id string, cfgs []Config, log *zerolog.Logger) (*Forwarder, error) {
+	opts := *options.Load()
+	if opts.QueueDir == "" {
+		return nil, ErrNoQueueDir
+	}
+
+	for i, cfg := range cfgs {
+		if err := cfg.Validate(); err != nil {
+			return nil, fmt.Errorf("sink %s: %w", cfg.displayName(i), err)
+		}
+	}
+
+	f := &Forwarder{}
+	dirs := queueDirs(opts.QueueDir, id, cfgs)
+	for i, cfg := range cfgs {
+		sink, err := cfg.newSink()
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("sink %s: %w", cfg.displayName(i), err)
+		}
+
+		name := cfg.displayName(i)
+		l := log.With().Str("sink", name).Logger()
+
+		if err := adoptLegacyQueue(opts.QueueDir, id, cfg, i, dirs[i]); err != nil {
+			l.Warn().Err(err).Msg("Failed to move the queue of the sink, its queued messages are left behind")
+		}
+
+		disk, err := newDiskQueue(dirs[i], opts.QueueMaxItems)
+		if err != nil {
+			_ = sink.Close()
+			f.Close()
+			return nil, fmt.Errorf("sink %s: %w", name, err)
+		}
+
+		f.sinks = append(f.sinks, newQueuedSink(sink, cfg.Retry, cfg.Block, disk, opts.RetryInterval, &l))
+	}
+
+	return f, nil
+}
+
+// Publish hands the event to every sink
+func (f *Forwarder) Publish(e Event) {
+	if f == nil {
+		return
+	}
+
+	for _, s := range f.sinks {
+		s.publish(e)
+	}
+}
+
+// Close stops the sinks, pending events are moved to the on-disk queues
+func (f *Forwarder) Close() {
+	if f == nil {
+		return
+	}
+
+	for _, s := range f.sinks {
+		s.close()
+	}
+}
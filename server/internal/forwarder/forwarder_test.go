@@ -0,0 +1,28 @@
+package forwarder
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestQueueDirsFollowSinks(t *testing.T) {
+	a := Config{Type: TypeWebhook, Webhook: &WebhookConfig{URL: "https://a.example.com"}}
+	b := Config{Type: TypeWebhook, Webhook: &WebhookConfig{URL: "https://b.example.com"}}
+	named := Config{Name: "siem", Type: TypeSyslog, Syslog: &SyslogConfig{Network: "udp", Address: "siem:514"}}
+
+	dirs := queueDirs("/q", "c1", []Config{a, b, named})
+	reordered := queueDirs("/q", "c1", []Config{named, b, a})
+	if dirs[0] != reordered[2] || dirs[1] != reordered[1] || dirs[2] != reordered[0] {
+		t.Errorf("queue dirs moved with the order of the sinks: %v, %v", dirs, reordered)
+	}
+
+	// unnamed sinks with the same destination are told apart
+	twice := queueDirs("/q", "c1", []Config{a, a})
+	if twice[0] == twice[1] {
+		t.Errorf("sinks with the same destination share queue dir %s", twice[0])
+	}
+
+	if other := queueDirs("/q", "c2", []Config{a}); slices.Contains(dirs, other[0]) {
+		t.Errorf("sinks of another connection share queue dir %s", other[0])
+	}
+}
@@ -0,0 +1,146 @@
+package forwarder
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+)
+
+const (
+	defaultKafkaTimeout  = 10 * time.Second
+	defaultKafkaClientID = "pxgrider"
+)
+
+type (
+	// KafkaConfig produces every event as a record to Topic, with the raw pxGrid
+	// message as the value and the event metadata in the headers. Records are keyed
+	// by "<connection id>/<topic>" or by the values found at KeyPath in the message,
+	// and partitioned like the Java client does, so the same key lands in the same partition.
+	KafkaConfig struct {
+		Brokers     []string      `json:"brokers"`
+		Topic       string        `json:"topic"`
+		ClientID    string        `json:"client_id,omitempty"`
+		KeyPath     string        `json:"key_path,omitempty"`
+		TLS         bool          `json:"tls,omitempty"`
+		InsecureTLS bool          `json:"insecure_tls,omitempty"`
+		Username    string        `json:"username,omitempty"`
+		Password    Secret        `json:"password,omitempty"`
+		Timeout     time.Duration `json:"timeout,omitempty"`
+	}
+
+	// kafkaSink waits for every record to be acknowledged by all in-sync replicas,
+	// which together with the retries of the queue gives at-least-once delivery
+	kafkaSink struct {
+		cfg    KafkaConfig
+		client *kgo.Client
+	}
+)
+
+func (c *KafkaConfig) Validate() error {
+	if len(c.Brokers) == 0 {
+		return errors.New("kafka sink requires brokers")
+	}
+	for _, b := range c.Brokers {
+		if _, _, err := net.SplitHostPort(b); err != nil {
+			return fmt.Errorf("bad kafka broker %q: %w", b, err)
+		}
+	}
+	if c.Topic == "" {
+		return errors.New("kafka sink requires a topic")
+	}
+	if c.Password != "" && c.Username == "" {
+		return errors.New("kafka password requires a username")
+	}
+	return nil
+}
+
+func newKafkaSink(cfg KafkaConfig) *kafkaSink {
+	if cfg.ClientID == "" {
+		cfg.ClientID = defaultKafkaClientID
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultKafkaTimeout
+	}
+
+	return &kafkaSink{cfg: cfg}
+}
+
+func (k *kafkaSink) connect() error {
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(k.cfg.Brokers...),
+		kgo.ClientID(k.cfg.ClientID),
+		kgo.DefaultProduceTopic(k.cfg.Topic),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+		// keyed records are hashed with murmur2 over all partitions of the topic,
+		// like the Java client, and wait for a partition that is offline
+		kgo.RecordPartitioner(kgo.StickyKeyPartitioner(nil)),
+		kgo.DialTimeout(k.cfg.Timeout),
+		// records carry the event time, so delivery is bounded by the context of
+		// Send rather than by a record timeout
+		kgo.ProduceRequestTimeout(k.cfg.Timeout),
+	}
+	if k.cfg.TLS {
+		opts = append(opts, kgo.DialTLSConfig(&tls.Config{
+			InsecureSkipVerify: k.cfg.InsecureTLS, //nolint:gosec
+			MinVersion:         tls.VersionTLS12,
+		}))
+	}
+	if k.cfg.Username != "" {
+		opts = append(opts, kgo.SASL(plain.Auth{
+			User: k.cfg.Username,
+			Pass: string(k.cfg.Password),
+		}.AsMechanism()))
+	}
+
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return fmt.Errorf("kafka client: %w", err)
+	}
+
+	k.client = client
+	return nil
+}
+
+func (k *kafkaSink) Send(ctx context.Context, e Event) error {
+	if k.client == nil {
+		if err := k.connect(); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, k.cfg.Timeout)
+	defer cancel()
+
+	return k.client.ProduceSync(ctx, kafkaRecord(e, k.cfg.KeyPath)).FirstErr()
+}
+
+func (k *kafkaSink) Close() error {
+	if k.client == nil {
+		return nil
+	}
+
+	k.client.Close()
+	k.client = nil
+	return nil
+}
+
+func kafkaRecord(e Event, keyPath string) *kgo.Record {
+	return &kgo.Record{
+		Key:       []byte(eventKey(e, keyPath)),
+		Value:     e.Message,
+		Timestamp: e.Timestamp,
+		Headers: []kgo.RecordHeader{
+			{Key: "connection_id", Value: []byte(e.ConnectionID)},
+			{Key: "service", Value: []byte(e.Service)},
+			{Key: "topic", Value: []byte(e.Topic)},
+			{Key: "message_id", Value: []byte(strconv.FormatInt(e.MessageID, 10))},
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package forwarder
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestKafkaProduce(t *testing.T) {
+	const partitions = 3
+
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions, "pxgrid"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cluster.Close()
+
+	s := newKafkaSink(KafkaConfig{Brokers: cluster.ListenAddrs(), Topic: "pxgrid", KeyPath: "sessions.callingStationId"})
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	macs := []string{"AA:AA", "BB:BB", "CC:CC", "DD:DD", "AA:AA", "BB:BB"}
+	for i, mac := range macs {
+		e := testEvent(int64(i + 1))
+		e.Message = json.RawMessage(`{"sessions":[{"callingStationId":"` + mac + `"}]}`)
+		if err := s.Send(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	consumer, err := kgo.NewClient(kgo.SeedBrokers(cluster.ListenAddrs()...), kgo.ConsumeTopics("pxgrid"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer consumer.Close()
+
+	partitioner := kgo.StickyKeyPartitioner(nil).ForTopic("pxgrid")
+	seen := make(map[string]int32)
+	for received := 0; received < len(macs); {
+		fetches := consumer.PollFetches(ctx)
+		if err := fetches.Err(); err != nil {
+			t.Fatal(err)
+		}
+
+		fetches.EachRecord(func(r *kgo.Record) {
+			received++
+
+			key := string(r.Key)
+			if p, ok := seen[key]; ok && p != r.Partition {
+				t.Errorf("key %s produced to partitions %d and %d", key, p, r.Partition)
+			}
+			seen[key] = r.Partition
+
+			if want := int32(partitioner.Partition(r, partitions)); r.Partition != want {
+				t.Errorf("key %s produced to partition %d, want %d", key, r.Partition, want)
+			}
+			if len(r.Headers) != 4 || r.Headers[0].Key != "connection_id" || string(r.Headers[0].Value) != "conn" {
+				t.Errorf("unexpected headers %+v", r.Headers)
+			}
+		})
+	}
+
+	if len(seen) != 4 {
+		t.Errorf("got %d keys, want 4", len(seen))
+	}
+}
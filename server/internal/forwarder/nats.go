@@ -0,0 +1,155 @@
+package forwarder
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	defaultNATSSubject = "pxgrider"
+	defaultNATSTimeout = 10 * time.Second
+)
+
+type (
+	// NATSConfig publishes the raw pxGrid message of every event to
+	// "<subject>.<connection id>.<topic>", followed by ".<key>" if KeyPath is set,
+	// with the event metadata in the headers. Core NATS publishes are flushed to the
+	// server before they count as delivered, JetStream ones wait for the stream ack
+	// and are deduplicated by the Nats-Msg-Id header.
+	NATSConfig struct {
+		URL         string        `json:"url"`
+		Subject     string        `json:"subject,omitempty"`
+		KeyPath     string        `json:"key_path,omitempty"`
+		JetStream   bool          `json:"jetstream,omitempty"`
+		Username    string        `json:"username,omitempty"`
+		Password    Secret        `json:"password,omitempty"`
+		Token       Secret        `json:"token,omitempty"`
+		InsecureTLS bool          `json:"insecure_tls,omitempty"`
+		Timeout     time.Duration `json:"timeout,omitempty"`
+	}
+
+	natsSink struct {
+		cfg NATSConfig
+		nc  *nats.Conn
+		js  jetstream.JetStream
+	}
+)
+
+var subjectTokenReplacer = strings.NewReplacer(".", "_", " ", "_", "*", "_", ">", "_", "\t", "_")
+
+func (c *NATSConfig) Validate() error {
+	if c.URL == "" {
+		return errors.New("nats sink requires an url")
+	}
+	if c.Subject != "" && strings.ContainsAny(c.Subject, " *>\t") {
+		return errors.New("nats subject must not contain spaces or wildcards")
+	}
+	return nil
+}
+
+func newNATSSink(cfg NATSConfig) *natsSink {
+	if cfg.Subject == "" {
+		cfg.Subject = defaultNATSSubject
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultNATSTimeout
+	}
+
+	return &natsSink{cfg: cfg}
+}
+
+func (n *natsSink) connect() error {
+	opts := []nats.Option{
+		nats.Name("pxgrider"),
+		nats.Timeout(n.cfg.Timeout),
+		nats.MaxReconnects(-1),
+	}
+	if n.cfg.Username != "" {
+		opts = append(opts, nats.UserInfo(n.cfg.Username, string(n.cfg.Password)))
+	}
+	if n.cfg.Token != "" {
+		opts = append(opts, nats.Token(string(n.cfg.Token)))
+	}
+	if n.cfg.InsecureTLS {
+		opts = append(opts, nats.Secure(&tls.Config{InsecureSkipVerify: true})) //nolint:gosec
+	}
+
+	nc, err := nats.Connect(n.cfg.URL, opts...)
+	if err != nil {
+		return err
+	}
+
+	if n.cfg.JetStream {
+		js, err := jetstream.New(nc)
+		if err != nil {
+			nc.Close()
+			return err
+		}
+		n.js = js
+	}
+
+	n.nc = nc
+	return nil
+}
+
+func (n *natsSink) Send(ctx context.Context, e Event) error {
+	if n.nc == nil {
+		if err := n.connect(); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.cfg.Timeout)
+	defer cancel()
+
+	msg := nats.NewMsg(n.subject(e))
+	msg.Data = e.Message
+	msg.Header.Set("Pxgrider-Connection-Id", e.ConnectionID)
+	msg.Header.Set("Pxgrider-Service", e.Service)
+	msg.Header.Set("Pxgrider-Topic", e.Topic)
+	msg.Header.Set("Pxgrider-Message-Id", strconv.FormatInt(e.MessageID, 10))
+	msg.Header.Set(jetstream.MsgIDHeader, e.ConnectionID+":"+strconv.FormatInt(e.MessageID, 10))
+
+	if n.js != nil {
+		_, err := n.js.PublishMsg(ctx, msg)
+		return err
+	}
+
+	if err := n.nc.PublishMsg(msg); err != nil {
+		return err
+	}
+
+	return n.nc.FlushWithContext(ctx)
+}
+
+func (n *natsSink) subject(e Event) string {
+	subject := n.cfg.Subject + "." + subjectToken(e.ConnectionID) + "." + subjectToken(e.Topic)
+	if n.cfg.KeyPath != "" {
+		subject += "." + subjectToken(eventKey(e, n.cfg.KeyPath))
+	}
+	return subject
+}
+
+func subjectToken(s string) string {
+	if s == "" {
+		return "_"
+	}
+	return subjectTokenReplacer.Replace(s)
+}
+
+func (n *natsSink) Close() error {
+	if n.nc == nil {
+		return nil
+	}
+
+	err := n.nc.Drain()
+	n.nc, n.js = nil, nil
+	return err
+}
@@ -0,0 +1,62 @@
+package forwarder
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// eventKey returns the values found at the dot separated path in the message
+// joined with commas, "<connection id>/<topic>" if path is empty or finds nothing
+func eventKey(e Event, path string) string {
+	if path != "" && len(e.Message) > 0 {
+		var msg any
+		if err := json.Unmarshal(e.Message, &msg); err == nil {
+			if values := lookup(msg, path); len(values) > 0 {
+				return strings.Join(values, ",")
+			}
+		}
+	}
+
+	return e.ConnectionID + "/" + e.Topic
+}
+
+// lookup returns the values at the dot separated path, arrays on the way are
+// walked element by element
+func lookup(msg any, path string) []string {
+	return resolvePath(msg, strings.Split(path, "."))
+}
+
+func resolvePath(v any, path []string) []string {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		var res []string
+		for _, el := range v {
+			res = append(res, resolvePath(el, path)...)
+		}
+		return res
+	case map[string]any:
+		if len(path) == 0 {
+			raw, _ := json.Marshal(v)
+			return []string{string(raw)}
+		}
+		return resolvePath(v[path[0]], path[1:])
+	}
+
+	if len(path) > 0 {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,295 @@
+package forwarder
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+)
+
+func ConfigFromProto(p *pb.Sink) (Config, error) {
+	cfg := Config{
+		Name:  p.GetName(),
+		Block: p.GetBlock(),
+		Retry: RetryConfig{
+			MaxAttempts: int(p.GetRetry().GetMaxAttempts()),
+			Backoff:     p.GetRetry().GetBackoff().AsDuration(),
+		},
+	}
+
+	switch k := p.GetKind().(type) {
+	case *pb.Sink_Webhook:
+		cfg.Type = TypeWebhook
+		cfg.Webhook = &WebhookConfig{
+			URL:         k.Webhook.GetUrl(),
+			Headers:     secretsFromProto(k.Webhook.GetHeaders()),
+			Secret:      Secret(k.Webhook.GetSecret()),
+			Timeout:     k.Webhook.GetTimeout().AsDuration(),
+			InsecureTLS: k.Webhook.GetInsecureTls(),
+		}
+	case *pb.Sink_Syslog:
+		cfg.Type = TypeSyslog
+		cfg.Syslog = syslogFromProto(k.Syslog)
+	case *pb.Sink_Nats:
+		cfg.Type = TypeNATS
+		cfg.NATS = &NATSConfig{
+			URL:         k.Nats.GetUrl(),
+			Subject:     k.Nats.GetSubject(),
+			KeyPath:     k.Nats.GetKeyPath(),
+			JetStream:   k.Nats.GetJetstream(),
+			Username:    k.Nats.GetUsername(),
+			Password:    Secret(k.Nats.GetPassword()),
+			Token:       Secret(k.Nats.GetToken()),
+			InsecureTLS: k.Nats.GetInsecureTls(),
+			Timeout:     k.Nats.GetTimeout().AsDuration(),
+		}
+	case *pb.Sink_Kafka:
+		cfg.Type = TypeKafka
+		cfg.Kafka = &KafkaConfig{
+			Brokers:     k.Kafka.GetBrokers(),
+			Topic:       k.Kafka.GetTopic(),
+			ClientID:    k.Kafka.GetClientId(),
+			KeyPath:     k.Kafka.GetKeyPath(),
+			TLS:         k.Kafka.GetTls(),
+			InsecureTLS: k.Kafka.GetInsecureTls(),
+			Username:    k.Kafka.GetUsername(),
+			Password:    Secret(k.Kafka.GetPassword()),
+			Timeout:     k.Kafka.GetTimeout().AsDuration(),
+		}
+	default:
+		return cfg, errors.New("sink kind is not set")
+	}
+
+	return cfg, cfg.Validate()
+}
+
+func ConfigsFromProto(ps []*pb.Sink) ([]Config, error) {
+	res := make([]Config, 0, len(ps))
+	for _, p := range ps {
+		cfg, err := ConfigFromProto(p)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, cfg)
+	}
+
+	return res, nil
+}
+
+// ToProto returns the sink without its secrets
+func (c Config) ToProto() *pb.Sink {
+	p := &pb.Sink{Name: c.Name, Block: c.Block}
+	if c.Retry.MaxAttempts > 0 || c.Retry.Backoff > 0 {
+		p.Retry = &pb.SinkRetry{
+			MaxAttempts: int32(c.Retry.MaxAttempts),
+			Backoff:     durationpb.New(c.Retry.Backoff),
+		}
+	}
+
+	switch c.Type {
+	case TypeWebhook:
+		if c.Webhook != nil {
+			p.Kind = &pb.Sink_Webhook{Webhook: &pb.WebhookSink{
+				Url:         c.Webhook.URL,
+				Headers:     redactSecrets(c.Webhook.Headers),
+				Timeout:     durationpb.New(c.Webhook.Timeout),
+				InsecureTls: c.Webhook.InsecureTLS,
+				HasSecret:   c.Webhook.Secret != "",
+			}}
+		}
+	case TypeSyslog:
+		if c.Syslog != nil {
+			p.Kind = &pb.Sink_Syslog{Syslog: c.Syslog.toProto()}
+		}
+	case TypeNATS:
+		if c.NATS != nil {
+			p.Kind = &pb.Sink_Nats{Nats: &pb.NatsSink{
+				Url:         c.NATS.URL,
+				Subject:     c.NATS.Subject,
+				KeyPath:     c.NATS.KeyPath,
+				Jetstream:   c.NATS.JetStream,
+				Username:    c.NATS.Username,
+				InsecureTls: c.NATS.InsecureTLS,
+				Timeout:     durationpb.New(c.NATS.Timeout),
+				HasPassword: c.NATS.Password != "",
+				HasToken:    c.NATS.Token != "",
+			}}
+		}
+	case TypeKafka:
+		if c.Kafka != nil {
+			p.Kind = &pb.Sink_Kafka{Kafka: &pb.KafkaSink{
+				Brokers:     c.Kafka.Brokers,
+				Topic:       c.Kafka.Topic,
+				ClientId:    c.Kafka.ClientID,
+				KeyPath:     c.Kafka.KeyPath,
+				Tls:         c.Kafka.TLS,
+				InsecureTls: c.Kafka.InsecureTLS,
+				Username:    c.Kafka.Username,
+				Timeout:     durationpb.New(c.Kafka.Timeout),
+				HasPassword: c.Kafka.Password != "",
+			}}
+		}
+	}
+
+	return p
+}
+
+// ClearSecrets removes the secrets of a sink sent by a client, like ToProto
+// header names are kept with empty values
+func ClearSecrets(p *pb.Sink) {
+	switch k := p.GetKind().(type) {
+	case *pb.Sink_Webhook:
+		for name := range k.Webhook.GetHeaders() {
+			k.Webhook.Headers[name] = ""
+		}
+		k.Webhook.HasSecret = k.Webhook.HasSecret || k.Webhook.GetSecret() != ""
+		k.Webhook.Secret = ""
+	case *pb.Sink_Nats:
+		k.Nats.HasPassword = k.Nats.HasPassword || k.Nats.GetPassword() != ""
+		k.Nats.HasToken = k.Nats.HasToken || k.Nats.GetToken() != ""
+		k.Nats.Password, k.Nats.Token = "", ""
+	case *pb.Sink_Kafka:
+		k.Kafka.HasPassword = k.Kafka.HasPassword || k.Kafka.GetPassword() != ""
+		k.Kafka.Password = ""
+	}
+}
+
+func syslogFromProto(p *pb.SyslogSink) *SyslogConfig {
+	cfg := &SyslogConfig{
+		Network:     SyslogUDP,
+		Address:     p.GetAddress(),
+		Format:      FormatRFC5424,
+		Facility:    p.GetFacility(),
+		Severity:    p.GetSeverity(),
+		AppName:     p.GetAppName(),
+		Hostname:    p.GetHostname(),
+		InsecureTLS: p.GetInsecureTls(),
+	}
+
+	switch p.GetNetwork() {
+	case pb.SyslogNetwork_SYSLOG_NETWORK_TCP:
+		cfg.Network = SyslogTCP
+	case pb.SyslogNetwork_SYSLOG_NETWORK_TLS:
+		cfg.Network = SyslogTLS
+	}
+
+	if p.GetFormat() == pb.SyslogFormat_SYSLOG_FORMAT_CEF {
+		cfg.Format = FormatCEF
+	}
+
+	if len(p.GetMappings()) > 0 {
+		cfg.Mappings = make(map[string]map[string]string, len(p.GetMappings()))
+		for topic, m := range p.GetMappings() {
+			cfg.Mappings[topic] = m.GetFields()
+		}
+	}
+
+	return cfg
+}
+
+func (c *SyslogConfig) toProto() *pb.SyslogSink {
+	p := &pb.SyslogSink{
+		Address:     c.Address,
+		Facility:    c.Facility,
+		Severity:    c.Severity,
+		AppName:     c.AppName,
+		Hostname:    c.Hostname,
+		InsecureTls: c.InsecureTLS,
+	}
+
+	switch c.Network {
+	case SyslogTCP:
+		p.Network = pb.SyslogNetwork_SYSLOG_NETWORK_TCP
+	case SyslogTLS:
+		p.Network = pb.SyslogNetwork_SYSLOG_NETWORK_TLS
+	default:
+		p.Network = pb.SyslogNetwork_SYSLOG_NETWORK_UDP
+	}
+
+	if c.Format == FormatCEF {
+		p.Format = pb.SyslogFormat_SYSLOG_FORMAT_CEF
+	}
+
+	if len(c.Mappings) > 0 {
+		p.Mappings = make(map[string]*pb.SyslogFieldMap, len(c.Mappings))
+		for topic, fields := range c.Mappings {
+			p.Mappings[topic] = &pb.SyslogFieldMap{Fields: fields}
+		}
+	}
+
+	return p
+}
+
+func ConfigsToProto(cfgs []Config) []*pb.Sink {
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	res := make([]*pb.Sink, 0, len(cfgs))
+	for _, c := range cfgs {
+		res = append(res, c.ToProto())
+	}
+
+	return res
+}
+
+func secretsFromProto(m map[string]string) map[string]Secret {
+	if len(m) == 0 {
+		return nil
+	}
+
+	res := make(map[string]Secret, len(m))
+	for k, v := range m {
+		res[k] = Secret(v)
+	}
+
+	return res
+}
+
+// redactSecrets returns the keys of m with empty values
+func redactSecrets(m map[string]Secret) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	res := make(map[string]string, len(m))
+	for k := range m {
+		res[k] = ""
+	}
+
+	return res
+}
+
+// KeepSecrets copies secrets of the previous sinks with the same name into the
+// new ones which were sent without them, as secrets are never returned to clients
+func KeepSecrets(cfgs, prev []Config) {
+	for i := range cfgs {
+		for _, p := range prev {
+			if p.Name == "" || p.Name != cfgs[i].Name || p.Type != cfgs[i].Type {
+				continue
+			}
+			if cfgs[i].Webhook != nil && p.Webhook != nil {
+				if cfgs[i].Webhook.Secret == "" {
+					cfgs[i].Webhook.Secret = p.Webhook.Secret
+				}
+				for k, v := range cfgs[i].Webhook.Headers {
+					if v == "" {
+						cfgs[i].Webhook.Headers[k] = p.Webhook.Headers[k]
+					}
+				}
+			}
+			if cfgs[i].NATS != nil && p.NATS != nil {
+				if cfgs[i].NATS.Password == "" {
+					cfgs[i].NATS.Password = p.NATS.Password
+				}
+				if cfgs[i].NATS.Token == "" {
+					cfgs[i].NATS.Token = p.NATS.Token
+				}
+			}
+			if cfgs[i].Kafka != nil && p.Kafka != nil && cfgs[i].Kafka.Password == "" {
+				cfgs[i].Kafka.Password = p.Kafka.Password
+			}
+		}
+	}
+}
@@ -0,0 +1,394 @@
+package forwarder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	memoryQueueSize = 1024
+	sendTimeout     = 30 * time.Second
+	maxBackoff      = 5 * time.Minute
+)
+
+type (
+	// queuedSink delivers events from an in-memory queue, retrying failed ones and
+	// parking them in the on-disk queue when retries are exhausted or memory is full.
+	// Blocking ones wait for room in memory and retry until delivered instead.
+	queuedSink struct {
+		sink     Sink
+		retry    RetryConfig
+		block    bool
+		disk     *diskQueue
+		interval time.Duration
+		log      *zerolog.Logger
+
+		ch   chan Event
+		done chan struct{}
+		wg   sync.WaitGroup
+		once sync.Once
+
+		// lock is held for reading by publishers, so that close waits for the
+		// ones in flight before draining the in-memory queue
+		lock   sync.RWMutex
+		closed bool
+
+		// failing, behind and full are logged when entered and left rather
+		// than for every message while the sink is down
+		failing state
+		behind  state
+		full    state
+	}
+
+	// state counts the messages affected while it's on
+	state struct {
+		on    atomic.Bool
+		count atomic.Int64
+	}
+
+	// diskQueue keeps events as files named in insertion order, dropping the oldest
+	// ones once max is reached
+	diskQueue struct {
+		lock  sync.Mutex
+		dir   string
+		max   int
+		count int
+		seq   uint64
+	}
+)
+
+func newQueuedSink(sink Sink, retry RetryConfig, block bool, disk *diskQueue, interval time.Duration, log *zerolog.Logger) *queuedSink {
+	if retry.MaxAttempts <= 0 {
+		retry.MaxAttempts = defaultMaxAttempts
+	}
+	if retry.Backoff <= 0 {
+		retry.Backoff = defaultBackoff
+	}
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	q := &queuedSink{
+		sink:     sink,
+		retry:    retry,
+		block:    block,
+		disk:     disk,
+		interval: interval,
+		log:      log,
+		ch:       make(chan Event, memoryQueueSize),
+		done:     make(chan struct{}),
+	}
+
+	q.wg.Add(1)
+	go q.run()
+
+	return q
+}
+
+// publish queues the event, events published once the sink is closed are
+// parked on disk
+func (q *queuedSink) publish(e Event) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	if q.closed {
+		q.park(e)
+		return
+	}
+
+	if q.block {
+		select {
+		case <-q.done:
+			q.park(e)
+		case q.ch <- e:
+		}
+		return
+	}
+
+	select {
+	case <-q.done:
+		q.park(e)
+	case q.ch <- e:
+		if n, ok := q.behind.leave(); ok {
+			q.log.Info().Int64("queued", n).Msg("Sink caught up")
+		}
+	default:
+		if q.behind.enter() {
+			q.log.Warn().Int64("message_id", e.MessageID).Msg("Sink is falling behind, queueing on disk")
+		}
+		q.park(e)
+	}
+}
+
+func (q *queuedSink) run() {
+	defer q.wg.Done()
+
+	ticker := time.NewTicker(q.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.done:
+			return
+		case e := <-q.ch:
+			if err := q.sendWithRetry(e); err != nil {
+				if q.failing.enter() {
+					q.log.Warn().Err(err).Int64("message_id", e.MessageID).Msg("Failed to forward message, queueing on disk")
+				}
+				q.park(e)
+				continue
+			}
+			q.recovered()
+		case <-ticker.C:
+			q.drainDisk()
+		}
+	}
+}
+
+func (q *queuedSink) send(e Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	return q.sink.Send(ctx, e)
+}
+
+func (q *queuedSink) sendWithRetry(e Event) error {
+	var err error
+	backoff := q.retry.Backoff
+	for attempt := 1; ; attempt++ {
+		if err = q.send(e); err == nil {
+			return nil
+		}
+		if attempt >= q.retry.MaxAttempts && !q.block {
+			return err
+		}
+
+		q.log.Debug().Err(err).Int("attempt", attempt).Dur("backoff", backoff).Msg("Retrying")
+		select {
+		case <-q.done:
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// drainDisk re-sends queued events oldest first, stopping at the first failure
+func (q *queuedSink) drainDisk() {
+	if q.disk == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-q.done:
+			return
+		default:
+		}
+
+		e, name, ok, err := q.disk.peek()
+		if err != nil && name == "" {
+			q.log.Error().Err(err).Msg("Failed to read on-disk queue")
+			return
+		}
+		if err != nil {
+			q.log.Error().Err(err).Str("file", name).Msg("Dropping unreadable queued event")
+			_ = q.disk.remove(name)
+			continue
+		}
+		if !ok {
+			return
+		}
+
+		if err := q.send(e); err != nil {
+			q.log.Debug().Err(err).Msg("Sink still failing, keeping queued events")
+			return
+		}
+		q.recovered()
+		if err := q.disk.remove(name); err != nil {
+			q.log.Error().Err(err).Msg("Failed to remove queued event")
+			return
+		}
+		if n, ok := q.full.leave(); ok {
+			q.log.Info().Int64("dropped", n).Msg("On-disk queue has room again")
+		}
+	}
+}
+
+// recovered logs the end of a failure once a message is delivered
+func (q *queuedSink) recovered() {
+	if n, ok := q.failing.leave(); ok {
+		q.log.Info().Int64("failed", n).Msg("Sink recovered")
+	}
+}
+
+func (q *queuedSink) park(e Event) {
+	if q.disk == nil {
+		q.log.Error().Int64("message_id", e.MessageID).Msg("Dropping message, on-disk queue is disabled")
+		return
+	}
+
+	dropped, err := q.disk.push(e)
+	if err != nil {
+		q.log.Error().Err(err).Int64("message_id", e.MessageID).Msg("Failed to queue message on disk")
+	}
+	for range dropped {
+		if q.full.enter() {
+			q.log.Warn().Msg("On-disk queue is full, dropping oldest messages")
+		}
+	}
+}
+
+func (q *queuedSink) close() {
+	q.once.Do(func() {
+		// done unblocks the publishers waiting for room, then the lock waits
+		// for those still in flight, which may have queued their event
+		close(q.done)
+		q.lock.Lock()
+		q.closed = true
+		q.lock.Unlock()
+		q.wg.Wait()
+
+	drain:
+		for {
+			select {
+			case e := <-q.ch:
+				q.park(e)
+			default:
+				break drain
+			}
+		}
+
+		if err := q.sink.Close(); err != nil {
+			q.log.Warn().Err(err).Msg("Failed to close sink")
+		}
+	})
+}
+
+// enter counts a message and reports whether the state was just entered
+func (s *state) enter() bool {
+	s.count.Add(1)
+	return s.on.CompareAndSwap(false, true)
+}
+
+// leave reports whether the state was on and the messages counted meanwhile
+func (s *state) leave() (int64, bool) {
+	if !s.on.CompareAndSwap(true, false) {
+		return 0, false
+	}
+	return s.count.Swap(0), true
+}
+
+func newDiskQueue(dir string, max int) (*diskQueue, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create queue dir: %w", err)
+	}
+
+	d := &diskQueue{dir: dir, max: max}
+	names, err := d.list()
+	if err != nil {
+		return nil, err
+	}
+	d.count = len(names)
+
+	return d, nil
+}
+
+func (d *diskQueue) list() ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			names = append(names, e.Name())
+		}
+	}
+
+	return names, nil
+}
+
+func (d *diskQueue) push(e Event) (int, error) {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return 0, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	dropped := 0
+	if d.max > 0 && d.count >= d.max {
+		names, err := d.list()
+		if err != nil {
+			return 0, err
+		}
+		for len(names) >= d.max {
+			if err := os.Remove(filepath.Join(d.dir, names[0])); err != nil && !os.IsNotExist(err) {
+				return dropped, err
+			}
+			names = names[1:]
+			dropped++
+		}
+		d.count = len(names)
+	}
+
+	d.seq++
+	name := fmt.Sprintf("%020d-%010d", time.Now().UnixNano(), d.seq)
+	tmp := filepath.Join(d.dir, name+".tmp")
+	if err := os.WriteFile(tmp, raw, 0o600); err != nil {
+		return dropped, err
+	}
+	if err := os.Rename(tmp, filepath.Join(d.dir, name+".json")); err != nil {
+		return dropped, err
+	}
+	d.count++
+
+	return dropped, nil
+}
+
+// peek returns the oldest queued event
+func (d *diskQueue) peek() (Event, string, bool, error) {
+	var e Event
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	names, err := d.list()
+	if err != nil || len(names) == 0 {
+		return e, "", false, err
+	}
+
+	raw, err := os.ReadFile(filepath.Join(d.dir, names[0]))
+	if err != nil {
+		return e, names[0], false, err
+	}
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return e, names[0], false, err
+	}
+
+	return e, names[0], true, nil
+}
+
+func (d *diskQueue) remove(name string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if err := os.Remove(filepath.Join(d.dir, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	d.count--
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package forwarder
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/vkumov/go-pxgrider/server/internal/secrets"
+)
+
+// secretAAD binds sealed sink secrets to their purpose, the same secret can't be
+// swapped with a sealed document of another kind such as connection credentials
+var secretAAD = []byte("forwarder/sink")
+
+// Secret is a sink setting sealed with the configured keyring when stored, it's
+// stored as a plain JSON string if encryption is disabled
+type Secret string
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	plain, err := json.Marshal(string(s))
+	if err != nil || s == "" {
+		return plain, err
+	}
+
+	return secrets.Seal(plain, secretAAD)
+}
+
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		plain, err := secrets.Open(data, secretAAD)
+		if err != nil {
+			return err
+		}
+		data = plain
+	}
+
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = Secret(v)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package forwarder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/vkumov/go-pxgrider/server/internal/secrets"
+)
+
+func TestSecretsAreSealed(t *testing.T) {
+	k, err := secrets.NewKeyring(map[string][]byte{"k1": bytes.Repeat([]byte{1}, 32)}, []string{"k1"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev := secrets.Current()
+	secrets.Configure(k)
+	t.Cleanup(func() { secrets.Configure(prev) })
+
+	cfg := Config{
+		Type: TypeNATS,
+		NATS: &NATSConfig{URL: "nats://localhost:4222", Username: "user", Password: "pa55word", Token: "t0ken"},
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, plain := range []string{"pa55word", "t0ken"} {
+		if bytes.Contains(data, []byte(plain)) {
+			t.Errorf("%q is stored in plain text: %s", plain, data)
+		}
+	}
+
+	var got Config
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.NATS.Password != "pa55word" || got.NATS.Token != "t0ken" || got.NATS.Username != "user" {
+		t.Errorf("unexpected config %+v", got.NATS)
+	}
+
+	// secrets stored before encryption was enabled are still read
+	var legacy Config
+	if err := json.Unmarshal([]byte(`{"type":"nats","nats":{"url":"nats://localhost:4222","password":"old"}}`), &legacy); err != nil {
+		t.Fatal(err)
+	}
+	if legacy.NATS.Password != "old" {
+		t.Errorf("password = %q, want old", legacy.NATS.Password)
+	}
+}
+
+func TestPlaintextSecretsUnlessEncryptionRequired(t *testing.T) {
+	prev := secrets.Current()
+	secrets.Configure(nil)
+	t.Cleanup(func() {
+		secrets.Configure(prev)
+		secrets.RequireEncryption(false)
+	})
+
+	cfg := Config{Type: TypeNATS, NATS: &NATSConfig{URL: "nats://localhost:4222", Token: "t0ken"}}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`"token":"t0ken"`)) {
+		t.Errorf("unexpected config %s", data)
+	}
+
+	secrets.RequireEncryption(true)
+	if _, err := json.Marshal(cfg); !errors.Is(err, secrets.ErrPlaintext) {
+		t.Errorf("err = %v, want ErrPlaintext", err)
+	}
+}
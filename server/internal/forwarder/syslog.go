@@ -0,0 +1,431 @@
+package forwarder
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	SyslogUDP = "udp"
+	SyslogTCP = "tcp"
+	SyslogTLS = "tls"
+
+	FormatRFC5424 = "rfc5424"
+	FormatCEF     = "cef"
+
+	defaultSyslogAppName  = "pxgrider"
+	defaultSyslogFacility = "local0"
+	defaultSyslogSeverity = "info"
+	syslogDialTimeout     = 10 * time.Second
+	// syslogMaxUDPSize is the datagram size receivers should accept (RFC 5426),
+	// longer messages are truncated
+	syslogMaxUDPSize = 2048
+
+	// structured data ID under the example enterprise number of RFC 5424
+	syslogSDID = "pxgrider@32473"
+
+	cefVendor  = "Cisco"
+	cefProduct = "pxGrid"
+	cefVersion = "2.0"
+)
+
+type (
+	// SyslogConfig sends events as RFC 5424 syslog messages or as CEF wrapped into
+	// RFC 5424. Mappings are keyed by topic and map an output field (a CEF extension
+	// key or a structured data parameter) to a dot separated path in the message.
+	// If all paths start with the same array, such as sessions, a message is sent
+	// per element of it, other arrays on the path are joined with commas.
+	SyslogConfig struct {
+		Network     string                       `json:"network"`
+		Address     string                       `json:"address"`
+		Format      string                       `json:"format"`
+		Facility    string                       `json:"facility,omitempty"`
+		Severity    string                       `json:"severity,omitempty"`
+		AppName     string                       `json:"app_name,omitempty"`
+		Hostname    string                       `json:"hostname,omitempty"`
+		InsecureTLS bool                         `json:"insecure_tls,omitempty"`
+		Mappings    map[string]map[string]string `json:"mappings,omitempty"`
+	}
+
+	syslogSink struct {
+		cfg      SyslogConfig
+		pri      int
+		cefSev   int
+		hostname string
+		conn     net.Conn
+	}
+)
+
+var (
+	syslogFacilities = map[string]int{
+		"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+		"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11, "ntp": 12, "security": 13, "console": 14,
+		"local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21,
+		"local6": 22, "local7": 23,
+	}
+
+	syslogSeverities = map[string]int{
+		"emerg": 0, "alert": 1, "crit": 2, "err": 3, "warning": 4, "notice": 5, "info": 6, "debug": 7,
+	}
+
+	// cefSeverities maps syslog severities to the 0-10 CEF scale
+	cefSeverities = [...]int{10, 9, 8, 7, 6, 4, 3, 1}
+
+	// DefaultSyslogMappings are used for topics without own mappings
+	DefaultSyslogMappings = map[string]map[string]string{
+		"sessionTopic":    sessionMapping,
+		"sessionTopicAll": sessionMapping,
+		"groupTopic": {
+			"suser": "user.userName",
+		},
+		"radiusFailureTopic": {
+			"suser":  "failures.userName",
+			"smac":   "failures.callingStationId",
+			"dvc":    "failures.nasIpAddress",
+			"reason": "failures.failureReason",
+		},
+	}
+
+	sessionMapping = map[string]string{
+		"suser":   "sessions.userName",
+		"smac":    "sessions.callingStationId",
+		"src":     "sessions.framedIpAddresses",
+		"dvc":     "sessions.nasIpAddress",
+		"outcome": "sessions.state",
+	}
+)
+
+func (c *SyslogConfig) Validate() error {
+	switch c.Network {
+	case SyslogUDP, SyslogTCP, SyslogTLS:
+	default:
+		return fmt.Errorf("unknown syslog network %q", c.Network)
+	}
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("bad syslog address: %w", err)
+	}
+	switch c.Format {
+	case FormatRFC5424, FormatCEF:
+	default:
+		return fmt.Errorf("unknown syslog format %q", c.Format)
+	}
+	if _, ok := syslogFacilities[c.facility()]; !ok {
+		return fmt.Errorf("unknown syslog facility %q", c.Facility)
+	}
+	if _, ok := syslogSeverities[c.severity()]; !ok {
+		return fmt.Errorf("unknown syslog severity %q", c.Severity)
+	}
+	for topic, fields := range c.Mappings {
+		for key, path := range fields {
+			if key == "" || strings.ContainsAny(key, " =]\"") {
+				return fmt.Errorf("topic %s: bad field name %q", topic, key)
+			}
+			if path == "" {
+				return fmt.Errorf("topic %s: empty path of field %s", topic, key)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *SyslogConfig) facility() string {
+	if c.Facility == "" {
+		return defaultSyslogFacility
+	}
+	return c.Facility
+}
+
+func (c *SyslogConfig) severity() string {
+	if c.Severity == "" {
+		return defaultSyslogSeverity
+	}
+	return c.Severity
+}
+
+func (c *SyslogConfig) mapping(topic string) map[string]string {
+	if m, ok := c.Mappings[topic]; ok {
+		return m
+	}
+	return DefaultSyslogMappings[topic]
+}
+
+func newSyslogSink(cfg SyslogConfig) *syslogSink {
+	if cfg.AppName == "" {
+		cfg.AppName = defaultSyslogAppName
+	}
+
+	hostname := cfg.Hostname
+	if hostname == "" {
+		hostname, _ = os.Hostname()
+	}
+	if hostname == "" {
+		hostname = "-"
+	}
+
+	sev := syslogSeverities[cfg.severity()]
+	return &syslogSink{
+		cfg:      cfg,
+		pri:      syslogFacilities[cfg.facility()]*8 + sev,
+		cefSev:   cefSeverities[sev],
+		hostname: hostname,
+	}
+}
+
+func (s *syslogSink) Send(ctx context.Context, e Event) error {
+	msgs, err := s.render(e)
+	if err != nil {
+		return err
+	}
+
+	if s.conn == nil {
+		if err := s.dial(ctx); err != nil {
+			return err
+		}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetWriteDeadline(deadline)
+	}
+
+	for _, msg := range msgs {
+		if s.cfg.Network == SyslogUDP {
+			msg = truncateUTF8(msg, syslogMaxUDPSize)
+		} else {
+			// stream transports use octet counting framing of RFC 6587
+			msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+		}
+
+		if _, err := s.conn.Write(msg); err != nil {
+			_ = s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *syslogSink) dial(ctx context.Context) error {
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	d := &net.Dialer{Timeout: syslogDialTimeout}
+	switch s.cfg.Network {
+	case SyslogTLS:
+		host, _, _ := net.SplitHostPort(s.cfg.Address)
+		td := &tls.Dialer{
+			NetDialer: d,
+			Config: &tls.Config{
+				ServerName:         host,
+				InsecureSkipVerify: s.cfg.InsecureTLS, //nolint:gosec
+				MinVersion:         tls.VersionTLS12,
+			},
+		}
+		conn, err = td.DialContext(ctx, "tcp", s.cfg.Address)
+	default:
+		conn, err = d.DialContext(ctx, s.cfg.Network, s.cfg.Address)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.conn = conn
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// render returns the messages of the event, one per element of the mapped root
+// array if there is one
+func (s *syslogSink) render(e Event) ([][]byte, error) {
+	var msg any
+	if len(e.Message) > 0 {
+		if err := json.Unmarshal(e.Message, &msg); err != nil {
+			return nil, fmt.Errorf("bad message: %w", err)
+		}
+	}
+
+	mapping := s.cfg.mapping(e.Topic)
+	root, elements := splitRoot(msg, mapping)
+	if root == "" {
+		return [][]byte{s.renderOne(e, e.Message, mapFields(msg, mapping))}, nil
+	}
+
+	relative := make(map[string]string, len(mapping))
+	for k, path := range mapping {
+		relative[k] = strings.TrimPrefix(path, root+".")
+	}
+
+	res := make([][]byte, 0, len(elements))
+	for _, el := range elements {
+		raw, err := json.Marshal(el)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s.renderOne(e, raw, mapFields(el, relative)))
+	}
+
+	return res, nil
+}
+
+func (s *syslogSink) renderOne(e Event, raw []byte, fields [][2]string) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%d>1 %s %s %s - %s ", s.pri, e.Timestamp.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		headerField(s.hostname, 255), headerField(s.cfg.AppName, 48), headerField(e.Topic, 32))
+
+	switch s.cfg.Format {
+	case FormatCEF:
+		b.WriteString("- ")
+		s.writeCEF(&b, e, raw, fields)
+	default:
+		writeSD(&b, e, fields)
+		if len(raw) > 0 {
+			b.WriteByte(' ')
+			b.Write(raw)
+		}
+	}
+
+	return []byte(b.String())
+}
+
+// splitRoot returns the first path element shared by every path of the mapping
+// and its elements if it's a non-empty array of the message, "" otherwise
+func splitRoot(msg any, mapping map[string]string) (string, []any) {
+	obj, ok := msg.(map[string]any)
+	if !ok || len(mapping) == 0 {
+		return "", nil
+	}
+
+	var root string
+	for _, path := range mapping {
+		first, _, ok := strings.Cut(path, ".")
+		if !ok || (root != "" && first != root) {
+			return "", nil
+		}
+		root = first
+	}
+
+	elements, ok := obj[root].([]any)
+	if !ok || len(elements) == 0 {
+		return "", nil
+	}
+
+	return root, elements
+}
+
+// truncateUTF8 cuts msg to at most max bytes without splitting a character
+func truncateUTF8(msg []byte, max int) []byte {
+	if len(msg) <= max {
+		return msg
+	}
+
+	cut := max
+	for cut > 0 && !utf8.RuneStart(msg[cut]) {
+		cut--
+	}
+
+	return msg[:cut]
+}
+
+func (s *syslogSink) writeCEF(b *strings.Builder, e Event, raw []byte, fields [][2]string) {
+	fmt.Fprintf(b, "CEF:0|%s|%s|%s|%s|%s|%d|", cefHeader(cefVendor), cefHeader(cefProduct), cefHeader(cefVersion),
+		cefHeader(e.Topic), cefHeader(e.Service+" "+e.Topic), s.cefSev)
+
+	fmt.Fprintf(b, "rt=%d externalId=%d cs1Label=connectionId cs1=%s", e.Timestamp.UnixMilli(), e.MessageID,
+		cefValue(e.ConnectionID))
+	for _, f := range fields {
+		b.WriteString(" " + f[0] + "=" + cefValue(f[1]))
+	}
+	if len(fields) == 0 && len(raw) > 0 {
+		b.WriteString(" msg=" + cefValue(string(raw)))
+	}
+}
+
+func writeSD(b *strings.Builder, e Event, fields [][2]string) {
+	b.WriteString("[" + syslogSDID)
+	params := append([][2]string{
+		{"connection", e.ConnectionID},
+		{"service", e.Service},
+		{"message_id", strconv.FormatInt(e.MessageID, 10)},
+	}, fields...)
+	for _, p := range params {
+		b.WriteString(" " + p[0] + "=\"" + sdValue(p[1]) + "\"")
+	}
+	b.WriteByte(']')
+}
+
+// mapFields resolves the mapping against the message, sorted by field name and
+// skipping fields without values
+func mapFields(msg any, mapping map[string]string) [][2]string {
+	keys := make([]string, 0, len(mapping))
+	for k := range mapping {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	res := make([][2]string, 0, len(keys))
+	for _, k := range keys {
+		values := lookup(msg, mapping[k])
+		if len(values) == 0 {
+			continue
+		}
+		res = append(res, [2]string{k, strings.Join(values, ",")})
+	}
+
+	return res
+}
+
+// headerField makes s a valid RFC 5424 header field: printable ASCII without
+// spaces, at most max characters, "-" if empty
+func headerField(s string, max int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, s)
+	if len(s) > max {
+		s = s[:max]
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+var (
+	sdEscaper        = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+	cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefValueEscaper  = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+func sdValue(s string) string {
+	return sdEscaper.Replace(s)
+}
+
+func cefHeader(s string) string {
+	return cefHeaderEscaper.Replace(s)
+}
+
+func cefValue(s string) string {
+	return cefValueEscaper.Replace(s)
+}
@@ -0,0 +1,88 @@
+package forwarder
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sessionEvent(sessions string) Event {
+	return Event{
+		ConnectionID: "conn",
+		Service:      "SessionDirectory",
+		Topic:        "sessionTopic",
+		MessageID:    7,
+		Timestamp:    time.Unix(1700000000, 0).UTC(),
+		Message:      json.RawMessage(`{"sessions":` + sessions + `}`),
+	}
+}
+
+func TestSyslogRecordPerSession(t *testing.T) {
+	s := newSyslogSink(SyslogConfig{Network: SyslogUDP, Address: "127.0.0.1:514", Format: FormatCEF, Hostname: "h"})
+
+	msgs, err := s.render(sessionEvent(`[
+		{"userName":"alice","callingStationId":"AA:AA","state":"STARTED"},
+		{"userName":"bob","callingStationId":"BB:BB","state":"DISCONNECTED"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("got %d records, want 2", len(msgs))
+	}
+
+	for i, want := range []string{"suser=alice", "suser=bob"} {
+		if !strings.Contains(string(msgs[i]), want) {
+			t.Errorf("record %d = %s, want %s", i, msgs[i], want)
+		}
+	}
+	if strings.Contains(string(msgs[0]), "bob") || strings.Contains(string(msgs[1]), "alice") {
+		t.Errorf("sessions are mixed up: %s / %s", msgs[0], msgs[1])
+	}
+}
+
+func TestSyslogWithoutRootArray(t *testing.T) {
+	s := newSyslogSink(SyslogConfig{Network: SyslogUDP, Address: "127.0.0.1:514", Format: FormatRFC5424, Hostname: "h"})
+
+	msgs, err := s.render(sessionEvent(`[]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("got %d records, want 1", len(msgs))
+	}
+}
+
+func TestSyslogUDPTruncation(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+
+	s := newSyslogSink(SyslogConfig{Network: SyslogUDP, Address: pc.LocalAddr().String(), Format: FormatRFC5424, Hostname: "h"})
+	defer s.Close()
+
+	long := strings.Repeat("é", 4096)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Send(ctx, sessionEvent(`[{"userName":"`+long+`"}]`)); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 64<<10)
+	_ = pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := pc.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n > syslogMaxUDPSize {
+		t.Errorf("datagram is %d bytes, want at most %d", n, syslogMaxUDPSize)
+	}
+	if !strings.HasPrefix(string(buf[:n]), "<134>1 ") || !strings.HasSuffix(string(buf[:n]), "é") {
+		t.Errorf("unexpected datagram %q", buf[:min(n, 64)])
+	}
+}
@@ -0,0 +1,125 @@
+package forwarder
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Pxgrider-Signature"
+	TimestampHeader = "X-Pxgrider-Timestamp"
+	TopicHeader     = "X-Pxgrider-Topic"
+
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+type (
+	WebhookConfig struct {
+		URL string `json:"url"`
+		// Headers are sent with every request, their values are stored as secrets
+		// as they usually carry credentials
+		Headers map[string]Secret `json:"headers,omitempty"`
+		// Secret signs the requests with HMAC-SHA256 of "<timestamp>.<body>"
+		Secret      Secret        `json:"secret,omitempty"`
+		Timeout     time.Duration `json:"timeout,omitempty"`
+		InsecureTLS bool          `json:"insecure_tls,omitempty"`
+	}
+
+	webhookSink struct {
+		cfg    WebhookConfig
+		client *http.Client
+	}
+)
+
+func (c *WebhookConfig) Validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("bad webhook url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("webhook url must be http or https")
+	}
+	return nil
+}
+
+func newWebhookSink(cfg WebhookConfig) *webhookSink {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.InsecureTLS {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	}
+
+	return &webhookSink{
+		cfg:    cfg,
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// Sign returns the signature of the body sent at ts, receivers compare it
+// against the X-Pxgrider-Signature header
+func Sign(secret string, ts int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *webhookSink) Send(ctx context.Context, e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	for k, v := range w.cfg.Headers {
+		req.Header.Set(k, string(v))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TopicHeader, e.Topic)
+
+	ts := time.Now().Unix()
+	req.Header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+	if w.cfg.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(string(w.cfg.Secret), ts, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (w *webhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
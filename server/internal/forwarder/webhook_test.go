@@ -0,0 +1,246 @@
+package forwarder
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+)
+
+// receiver records the events it accepts, the first failures requests are
+// answered with a server error
+type receiver struct {
+	t        *testing.T
+	secret   string
+	lock     sync.Mutex
+	failures int
+	requests int
+	events   []Event
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		rc.t.Errorf("read body: %v", err)
+		return
+	}
+
+	ts, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		rc.t.Errorf("bad timestamp header: %v", err)
+	}
+	if got, want := r.Header.Get(SignatureHeader), Sign(rc.secret, ts, body); got != want {
+		rc.t.Errorf("signature = %q, want %q", got, want)
+	}
+	if got := r.Header.Get("Authorization"); got != "Bearer token" {
+		rc.t.Errorf("authorization header = %q", got)
+	}
+
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	rc.requests++
+	if rc.failures > 0 {
+		rc.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var e Event
+	if err := json.Unmarshal(body, &e); err != nil {
+		rc.t.Errorf("unmarshal event: %v", err)
+	}
+	rc.events = append(rc.events, e)
+}
+
+func (rc *receiver) setFailures(n int) {
+	rc.lock.Lock()
+	rc.failures = n
+	rc.lock.Unlock()
+}
+
+func (rc *receiver) delivered() ([]Event, int) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	return append([]Event(nil), rc.events...), rc.requests
+}
+
+func newTestForwarder(t *testing.T, url string, attempts int) (*Forwarder, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	prev := *options.Load()
+	Configure(Options{QueueDir: dir, QueueMaxItems: 100, RetryInterval: 20 * time.Millisecond})
+	t.Cleanup(func() { Configure(prev) })
+
+	log := zerolog.Nop()
+	f, err := New("conn", []Config{{
+		Name: "hook",
+		Type: TypeWebhook,
+		Webhook: &WebhookConfig{
+			URL:     url,
+			Secret:  "s3cret",
+			Headers: map[string]Secret{"Authorization": "Bearer token"},
+		},
+		Retry: RetryConfig{MaxAttempts: attempts, Backoff: time.Millisecond},
+	}}, &log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(f.Close)
+
+	return f, dir
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func testEvent(id int64) Event {
+	return Event{
+		ConnectionID: "conn",
+		Service:      "SessionDirectory",
+		Topic:        "sessionTopic",
+		MessageID:    id,
+		Timestamp:    time.Unix(1700000000, 0).UTC(),
+		Message:      json.RawMessage(`{"sessions":[]}`),
+	}
+}
+
+func TestWebhookRetries(t *testing.T) {
+	rc := &receiver{t: t, secret: "s3cret", failures: 2}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	f, _ := newTestForwarder(t, srv.URL, 3)
+	f.Publish(testEvent(1))
+
+	waitFor(t, func() bool {
+		events, _ := rc.delivered()
+		return len(events) == 1
+	})
+
+	events, requests := rc.delivered()
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+	if events[0].MessageID != 1 || events[0].Topic != "sessionTopic" {
+		t.Errorf("unexpected event %+v", events[0])
+	}
+}
+
+func TestWebhookReplaysQueue(t *testing.T) {
+	rc := &receiver{t: t, secret: "s3cret", failures: 1 << 30}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	f, _ := newTestForwarder(t, srv.URL, 1)
+	disk := f.sinks[0].disk
+
+	f.Publish(testEvent(1))
+	f.Publish(testEvent(2))
+	waitFor(t, func() bool {
+		disk.lock.Lock()
+		defer disk.lock.Unlock()
+		return disk.count == 2
+	})
+
+	rc.setFailures(0)
+	waitFor(t, func() bool {
+		events, _ := rc.delivered()
+		return len(events) == 2
+	})
+
+	events, _ := rc.delivered()
+	if events[0].MessageID != 1 || events[1].MessageID != 2 {
+		t.Errorf("events replayed out of order: %d, %d", events[0].MessageID, events[1].MessageID)
+	}
+	waitFor(t, func() bool {
+		names, err := disk.list()
+		return err == nil && len(names) == 0
+	})
+}
+
+func TestPublishAfterCloseIsQueued(t *testing.T) {
+	rc := &receiver{t: t, secret: "s3cret"}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	f, _ := newTestForwarder(t, srv.URL, 1)
+	disk := f.sinks[0].disk
+	f.Close()
+
+	f.Publish(testEvent(1))
+
+	names, err := disk.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 {
+		t.Fatalf("queued %d events, want 1", len(names))
+	}
+}
+
+func TestSecretsAreRedacted(t *testing.T) {
+	cfg := Config{
+		Name: "hook",
+		Type: TypeWebhook,
+		Webhook: &WebhookConfig{
+			URL:     "https://example.com",
+			Secret:  "s3cret",
+			Headers: map[string]Secret{"Authorization": "Bearer token"},
+		},
+	}
+
+	p := cfg.ToProto().GetWebhook()
+	if !p.GetHasSecret() || p.GetSecret() != "" {
+		t.Errorf("secret is returned or not reported")
+	}
+	if v, ok := p.GetHeaders()["Authorization"]; !ok || v != "" {
+		t.Errorf("header value = %q, want redacted", v)
+	}
+
+	update, err := ConfigFromProto(cfg.ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfgs := []Config{update}
+	KeepSecrets(cfgs, []Config{cfg})
+	if cfgs[0].Webhook.Secret != "s3cret" || cfgs[0].Webhook.Headers["Authorization"] != "Bearer token" {
+		t.Errorf("secrets are not kept: %+v", cfgs[0].Webhook)
+	}
+}
+
+func TestClearSecrets(t *testing.T) {
+	p := &pb.Sink{Kind: &pb.Sink_Webhook{Webhook: &pb.WebhookSink{
+		Url:     "https://example.com",
+		Secret:  "s3cret",
+		Headers: map[string]string{"X-Api-Key": "k3y"},
+	}}}
+
+	ClearSecrets(p)
+	w := p.GetWebhook()
+	if w.GetSecret() != "" || !w.GetHasSecret() {
+		t.Errorf("secret = %q, has secret = %v", w.GetSecret(), w.GetHasSecret())
+	}
+	if v, ok := w.GetHeaders()["X-Api-Key"]; !ok || v != "" {
+		t.Errorf("header value = %q, want redacted", v)
+	}
+}
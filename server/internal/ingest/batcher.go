@@ -0,0 +1,256 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
+	"github.com/vkumov/go-pxgrider/server/internal/tracing"
+)
+
+var ErrClosed = errors.New("ingest pipeline is closed")
+
+type (
+	item[T any] struct {
+		row  T
+		done func(error)
+		// sync is closed once the rows queued before it are handled
+		sync chan struct{}
+	}
+
+	// batcher inserts rows of a table in batches flushed by size or interval.
+	// A failed batch is retried while the database is unreachable, which blocks
+	// the queue and so the producers, rows rejected by a reachable database are
+	// inserted one by one and the rejected ones are dropped.
+	batcher[T any] struct {
+		table string
+		opts  Options
+		log   *zerolog.Logger
+
+		insertBatch func(ctx context.Context, rows []T) error
+		insertOne   func(ctx context.Context, row T) error
+		ping        func(ctx context.Context) error
+
+		in chan item[T]
+		// lock guards closed and the registration of senders, it isn't held
+		// while sending so that close doesn't wait for a full queue
+		lock    sync.RWMutex
+		closed  bool
+		senders sync.WaitGroup
+		closing chan struct{}
+		// abort is closed once the ctx of any close call is done
+		abort     chan struct{}
+		abortOnce sync.Once
+		done      chan struct{}
+	}
+)
+
+func newBatcher[T any](table string, opts Options, log *zerolog.Logger) *batcher[T] {
+	return &batcher[T]{
+		table:   table,
+		opts:    opts,
+		log:     log,
+		in:      make(chan item[T], opts.QueueSize),
+		closing: make(chan struct{}),
+		abort:   make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// submit queues the row, it blocks while the queue is full until ctx is done
+func (b *batcher[T]) submit(ctx context.Context, row T, done func(error)) error {
+	return b.send(ctx, item[T]{row: row, done: done})
+}
+
+// sync waits until the rows queued before are handled
+func (b *batcher[T]) sync(ctx context.Context) error {
+	ch := make(chan struct{})
+	if err := b.send(ctx, item[T]{sync: ch}); err != nil {
+		return err
+	}
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *batcher[T]) send(ctx context.Context, it item[T]) error {
+	b.lock.RLock()
+	if b.closed {
+		b.lock.RUnlock()
+		return ErrClosed
+	}
+	b.senders.Add(1)
+	b.lock.RUnlock()
+	defer b.senders.Done()
+
+	// the depth is counted before the send, run may receive the item and
+	// decrement it before the send returns
+	depth := metrics.IngestQueueDepth.WithLabelValues(b.table)
+	if it.sync == nil {
+		depth.Inc()
+	}
+	err := b.enqueue(ctx, it)
+	if err != nil && it.sync == nil {
+		depth.Dec()
+	}
+
+	return err
+}
+
+func (b *batcher[T]) enqueue(ctx context.Context, it item[T]) error {
+	select {
+	case b.in <- it:
+		return nil
+	case <-b.closing:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *batcher[T]) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]item[T], 0, b.opts.BatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			b.flush(batch)
+			batch = batch[:0]
+		}
+	}
+
+	for {
+		select {
+		case it, ok := <-b.in:
+			if !ok {
+				flush()
+				return
+			}
+			if it.sync != nil {
+				flush()
+				close(it.sync)
+				continue
+			}
+
+			metrics.IngestQueueDepth.WithLabelValues(b.table).Dec()
+			batch = append(batch, it)
+			if len(batch) >= b.opts.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (b *batcher[T]) flush(batch []item[T]) {
+	ctx, span := tracing.Start(context.Background(), "ingest.flush",
+		attribute.String("ingest.table", b.table),
+		attribute.Int("ingest.rows", len(batch)),
+	)
+	start := time.Now()
+
+	rows := make([]T, len(batch))
+	for i, it := range batch {
+		rows[i] = it.row
+	}
+
+	err := b.insertWithRetry(ctx, rows)
+	switch {
+	case err == nil:
+		metrics.IngestBatchRows.WithLabelValues(b.table).Observe(float64(len(rows)))
+		for _, it := range batch {
+			it.done(nil)
+		}
+	case errors.Is(err, errAborted):
+		metrics.IngestDropped.WithLabelValues(b.table, "shutdown").Add(float64(len(rows)))
+		b.log.Error().Err(err).Int("rows", len(rows)).Msg("Dropped rows which couldn't be inserted before shutdown")
+		for _, it := range batch {
+			it.done(err)
+		}
+	default:
+		b.insertEach(ctx, batch)
+	}
+
+	metrics.IngestFlushDuration.WithLabelValues(b.table).Observe(time.Since(start).Seconds())
+	tracing.End(span, err)
+}
+
+var errAborted = errors.New("ingest aborted")
+
+// insertWithRetry retries the batch while the database is unreachable, it
+// returns the error of the batch once the database answers pings
+func (b *batcher[T]) insertWithRetry(ctx context.Context, rows []T) error {
+	for {
+		err := b.insertBatch(ctx, rows)
+		if err == nil {
+			return nil
+		}
+		metrics.IngestFlushErrors.WithLabelValues(b.table).Inc()
+
+		if b.ping(ctx) == nil {
+			return err
+		}
+
+		b.log.Warn().Err(err).Int("rows", len(rows)).Dur("retry_in", b.opts.RetryInterval).
+			Msg("Database is unavailable, retrying batch")
+		select {
+		case <-time.After(b.opts.RetryInterval):
+		case <-b.abort:
+			return errors.Join(errAborted, err)
+		}
+	}
+}
+
+// insertEach inserts the rows of a rejected batch one by one to drop only the
+// rejected ones, for instance logs of a deleted connection
+func (b *batcher[T]) insertEach(ctx context.Context, batch []item[T]) {
+	inserted := 0
+	for _, it := range batch {
+		err := b.insertOne(ctx, it.row)
+		if err != nil {
+			metrics.IngestDropped.WithLabelValues(b.table, "rejected").Inc()
+			b.log.Warn().Err(err).Msg("Row rejected by the database, dropping it")
+		} else {
+			inserted++
+		}
+		it.done(err)
+	}
+	metrics.IngestBatchRows.WithLabelValues(b.table).Observe(float64(inserted))
+}
+
+// close stops accepting rows and waits until the queued ones are handled, rows
+// still waiting for the database when ctx is done are dropped
+func (b *batcher[T]) close(ctx context.Context) error {
+	stop := context.AfterFunc(ctx, func() {
+		b.abortOnce.Do(func() { close(b.abort) })
+	})
+	defer stop()
+
+	b.lock.Lock()
+	first := !b.closed
+	b.closed = true
+	b.lock.Unlock()
+
+	if first {
+		// blocked senders give up, in is closed once none is sending
+		close(b.closing)
+		b.senders.Wait()
+		close(b.in)
+	}
+	<-b.done
+
+	return ctx.Err()
+}
@@ -0,0 +1,226 @@
+package ingest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	pxdb "github.com/vkumov/go-pxgrider/server/internal/db"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+)
+
+type (
+	Options struct {
+		// BatchSize flushes a table once that many rows are queued
+		BatchSize int
+		// FlushInterval flushes rows queued for that long even if the batch isn't full
+		FlushInterval time.Duration
+		// QueueSize bounds the rows waiting per table, producers block when it's full
+		QueueSize int
+		// RetryInterval is how often a batch is retried while the database is unavailable
+		RetryInterval time.Duration
+		// MaxParams bounds the parameters of a statement, batches are split to fit
+		MaxParams int
+	}
+
+	// Pipeline inserts messages and logs in multi-row batches. Done callbacks are
+	// called from the goroutine of the table and must not block.
+	Pipeline struct {
+		messages *batcher[*models.Message]
+		logs     *batcher[*models.Log]
+	}
+)
+
+var (
+	messageColumns = []string{
+		models.MessageColumns.Client,
+		models.MessageColumns.Topic,
+		models.MessageColumns.Message,
+		models.MessageColumns.Timestamp,
+		models.MessageColumns.Viewed,
+		models.MessageColumns.Service,
+	}
+	logColumns = []string{
+		models.LogColumns.Client,
+		models.LogColumns.Level,
+		models.LogColumns.Timestamp,
+		models.LogColumns.Message,
+		models.LogColumns.Label,
+	}
+)
+
+// New starts the pipeline of the database, it runs until Close
+func New(db *sql.DB, dialect pxdb.Dialect, o Options, log *zerolog.Logger) *Pipeline {
+	o.BatchSize = max(o.BatchSize, 1)
+	o.QueueSize = max(o.QueueSize, o.BatchSize)
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = 250 * time.Millisecond
+	}
+	if o.RetryInterval <= 0 {
+		o.RetryInterval = time.Second
+	}
+	ping := func(ctx context.Context) error { return db.PingContext(ctx) }
+
+	messages := newBatcher[*models.Message](models.TableNames.Messages, o, log)
+	messages.ping = ping
+	messages.insertBatch = func(ctx context.Context, rows []*models.Message) error {
+		return insertChunked(ctx, db, dialect, models.TableNames.Messages, messageColumns, o.MaxParams, rows,
+			func(m *models.Message) []any {
+				return []any{m.Client, m.Topic, m.Message, m.Timestamp, m.Viewed, m.Service}
+			},
+			func(m *models.Message, id int64) { m.ID = id },
+		)
+	}
+	messages.insertOne = func(ctx context.Context, m *models.Message) error {
+		// the ID may be left over by the failed batch
+		m.ID = 0
+		return m.Insert(ctx, db, boil.Infer())
+	}
+
+	logs := newBatcher[*models.Log](models.TableNames.Logs, o, log)
+	logs.ping = ping
+	logs.insertBatch = func(ctx context.Context, rows []*models.Log) error {
+		return insertChunked(ctx, db, dialect, models.TableNames.Logs, logColumns, o.MaxParams, rows,
+			func(l *models.Log) []any {
+				return []any{l.Client, l.Level, l.Timestamp, l.Message, l.Label}
+			},
+			func(l *models.Log, id int64) { l.ID = id },
+		)
+	}
+	logs.insertOne = func(ctx context.Context, l *models.Log) error {
+		l.ID = 0
+		return l.Insert(ctx, db, boil.Infer())
+	}
+
+	go messages.run()
+	go logs.run()
+
+	return &Pipeline{messages: messages, logs: logs}
+}
+
+// InsertMessage queues the message, its ID is set before done is called with a
+// nil error. It blocks while the queue is full until ctx is done.
+func (p *Pipeline) InsertMessage(ctx context.Context, m *models.Message, done func(error)) error {
+	if p == nil {
+		return ErrClosed
+	}
+
+	return p.messages.submit(ctx, m, done)
+}
+
+// InsertLog queues the log entry, it blocks while the queue is full until ctx is done
+func (p *Pipeline) InsertLog(ctx context.Context, l *models.Log, done func(error)) error {
+	if p == nil {
+		return ErrClosed
+	}
+
+	return p.logs.submit(ctx, l, done)
+}
+
+// Sync waits until the rows queued before are inserted or dropped
+func (p *Pipeline) Sync(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+
+	return errors.Join(p.messages.sync(ctx), p.logs.sync(ctx))
+}
+
+// Close stops accepting rows and flushes the queued ones, rows still waiting for
+// an unavailable database when ctx is done are dropped
+func (p *Pipeline) Close(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+
+	errs := make(chan error, 1)
+	go func() { errs <- p.logs.close(ctx) }()
+
+	return errors.Join(p.messages.close(ctx), <-errs)
+}
+
+// insertChunked inserts the rows with multi-row statements of at most maxParams
+// parameters. IDs are reserved up front since databases don't guarantee the
+// order of the rows returned by a multi-row insert, they are set once committed.
+func insertChunked[T any](
+	ctx context.Context, db *sql.DB, dialect pxdb.Dialect, table string, columns []string, maxParams int,
+	rows []T, values func(T) []any, setID func(T, int64),
+) error {
+	columns = append([]string{"id"}, columns...)
+	perStatement := len(rows)
+	if maxParams > 0 {
+		perStatement = max(maxParams/len(columns), 1)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	ids, err := dialect.ReserveIDs(ctx, tx, table, len(rows))
+	if err != nil {
+		return fmt.Errorf("failed to reserve IDs of %s: %w", table, err)
+	}
+
+	for start := 0; start < len(rows); start += perStatement {
+		end := min(start+perStatement, len(rows))
+
+		query, args := multiRowInsert(table, columns, rows[start:end], ids[start:end], values)
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err == nil && n != int64(end-start) {
+			return fmt.Errorf("inserted %d rows into %s, expected %d", n, table, end-start)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for i, row := range rows {
+		setID(row, ids[i])
+	}
+
+	return nil
+}
+
+func multiRowInsert[T any](table string, columns []string, rows []T, ids []int64, values func(T) []any) (string, []any) {
+	var (
+		b    strings.Builder
+		args = make([]any, 0, len(rows)*len(columns))
+	)
+
+	fmt.Fprintf(&b, `INSERT INTO %q (`, table)
+	for i, c := range columns {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%q", c)
+	}
+	b.WriteString(") VALUES ")
+
+	for i, row := range rows {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteByte('(')
+		for j, v := range append([]any{ids[i]}, values(row)...) {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			args = append(args, v)
+			fmt.Fprintf(&b, "$%d", len(args))
+		}
+		b.WriteByte(')')
+	}
+
+	return b.String(), args
+}
@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/vkumov/go-pxgrider/server/internal/connection"
+)
+
+// runJanitor periodically enforces retention on every connection
+func (a *App) runJanitor() {
+	specs := a.cfg.Specs.Retention
+	if specs.Interval <= 0 {
+		a.cfg.Logger().Info().Msg("Retention janitor is disabled")
+		return
+	}
+
+	global := connection.Retention{
+		MessagesMaxAge:  specs.MessagesMaxAge,
+		MessagesMaxRows: specs.MessagesMaxRows,
+		LogsMaxAge:      specs.LogsMaxAge,
+		LogsMaxRows:     specs.LogsMaxRows,
+	}
+
+	ticker := time.NewTicker(specs.Interval)
+	defer ticker.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-a.stopping:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		a.enforceRetention(ctx, global)
+		select {
+		case <-ticker.C:
+		case <-a.stopping:
+			return
+		}
+	}
+}
+
+// enforceRetention applies retention to every connection, failures are logged
+// by the connections
+func (a *App) enforceRetention(ctx context.Context, global connection.Retention) {
+	log := a.cfg.Logger()
+
+	var total connection.RetentionReport
+	for _, c := range a.users.AllConnections() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		report, _ := c.EnforceRetention(ctx, global)
+		total.MessagesDeleted += report.MessagesDeleted
+		total.LogsDeleted += report.LogsDeleted
+	}
+
+	log.Debug().Int64("messages", total.MessagesDeleted).Int64("logs", total.LogsDeleted).
+		Msg("Retention enforced")
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+)
+
+// WriteLevel prints the event to the original writer and stores it in the
+// database if its level reaches the threshold of each
+func (w *combinedWriter) WriteLevel(level zerolog.Level, p []byte) (n int, err error) {
+	if level >= zerolog.Level(w.stdoutLevel.Load()) {
+		if _, err = w.originalWriter.Write(p); err != nil {
+			return 0, err
+		}
+	}
+	if level >= zerolog.Level(w.dbLevel.Load()) {
+		if err = w.store(p); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Sample lets events through if any destination takes them, so that events
+// below both levels aren't built. It's shared by the loggers derived with
+// With, changes of levels apply to all of them.
+func (w *combinedWriter) Sample(level zerolog.Level) bool {
+	return level >= min(zerolog.Level(w.stdoutLevel.Load()), zerolog.Level(w.dbLevel.Load()))
+}
+
+// SetLevels sets the minimum levels of events printed to stdout and stored in
+// the database, an empty level resets it to the level of the parent logger
+func (l *Logger) SetLevels(stdout, db string) error {
+	stdoutLevel, err := l.parseLevel(stdout)
+	if err != nil {
+		return fmt.Errorf("stdout level: %w", err)
+	}
+	dbLevel, err := l.parseLevel(db)
+	if err != nil {
+		return fmt.Errorf("db level: %w", err)
+	}
+
+	l.w.stdoutLevel.Store(int32(stdoutLevel))
+	l.w.dbLevel.Store(int32(dbLevel))
+	return nil
+}
+
+// Levels returns the minimum levels of events printed to stdout and stored in
+// the database
+func (l *Logger) Levels() (stdout, db zerolog.Level) {
+	return zerolog.Level(l.w.stdoutLevel.Load()), zerolog.Level(l.w.dbLevel.Load())
+}
+
+func (l *Logger) parseLevel(level string) (zerolog.Level, error) {
+	if level == "" {
+		return l.parent, nil
+	}
+
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return zerolog.NoLevel, err
+	}
+	if lvl == zerolog.NoLevel {
+		return zerolog.NoLevel, fmt.Errorf("unknown level %q", level)
+	}
+	return lvl, nil
+}
@@ -3,62 +3,99 @@ package logger
 import (
 	"bytes"
 	"context"
-	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/ingest"
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
 	"github.com/volatiletech/null/v8"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type (
 	combinedWriter struct {
 		originalWriter io.Writer
-		db             *sql.DB
+		ingest         *ingest.Pipeline
 		eventStream    chan map[string]interface{}
+		done           chan struct{}
+		opts           Options
+		overflows      atomic.Uint64
+		stdoutLevel    atomic.Int32
+		dbLevel        atomic.Int32
+
+		// drain is cancelled once the events left at Stop had their time
+		drain       context.Context
+		cancelDrain context.CancelFunc
+
+		// lock guards closed, events written after Stop only go to the original writer
+		lock   sync.RWMutex
+		closed bool
 	}
 
 	Logger struct {
 		*zerolog.Logger
 		w *combinedWriter
+		// parent is the level of the logger it was created from, the default
+		// of both levels
+		parent zerolog.Level
 	}
 )
 
+const (
+	// insertTimeout bounds the wait for room in the ingest queue, events which
+	// don't fit in time are dropped like on overflow of the writer queue
+	insertTimeout = time.Second
+	// stopTimeout bounds the storing of the events queued when Stop is called
+	stopTimeout = 5 * time.Second
+)
+
 const (
 	ConnectionIdFieldName = "connection_id"
 	ComponentFieldName    = "component"
 	UsernameFieldName     = "username"
-
-	queueSize = 32
 )
 
-func NewCombined(connectionId string, fromLogger *zerolog.Logger, db *sql.DB, writer io.Writer, fields ...any) *Logger {
+// NewCombined returns a logger of the connection writing to writer and storing
+// events in the database through the pipeline
+func NewCombined(connectionId string, pipeline *ingest.Pipeline, fromLogger *zerolog.Logger, writer io.Writer, fields ...any) *Logger {
 	builder := fromLogger.With().Str(ConnectionIdFieldName, connectionId)
 
 	if len(fields) > 0 {
 		builder = builder.Fields(fields)
 	}
 
-	stdoutLogger := builder.Logger()
-	wr := newCombinedWriter(db, writer)
-	dbLogger := stdoutLogger.Output(wr)
+	stdoutLogger := builder.Logger().Hook(traceHook{})
+	wr := newCombinedWriter(writer, pipeline)
+	wr.stdoutLevel.Store(int32(fromLogger.GetLevel()))
+	wr.dbLevel.Store(int32(fromLogger.GetLevel()))
+	// levels are checked by the writer, they may be below the parent one
+	dbLogger := stdoutLogger.Output(wr).Level(zerolog.TraceLevel).Sample(wr)
 
 	return &Logger{
 		Logger: &dbLogger,
 		w:      wr,
+		parent: fromLogger.GetLevel(),
 	}
 }
 
-func newCombinedWriter(db *sql.DB, writer io.Writer) *combinedWriter {
+func newCombinedWriter(writer io.Writer, pipeline *ingest.Pipeline) *combinedWriter {
+	opts := *options.Load()
+	drain, cancelDrain := context.WithCancel(context.Background())
 	c := &combinedWriter{
-		db:             db,
 		originalWriter: writer,
-		eventStream:    make(chan map[string]any, queueSize),
+		ingest:         pipeline,
+		drain:          drain,
+		cancelDrain:    cancelDrain,
+		eventStream:    make(chan map[string]any, opts.QueueSize),
+		done:           make(chan struct{}),
+		opts:           opts,
 	}
 
 	go c.storeEvent()
@@ -67,58 +104,81 @@ func newCombinedWriter(db *sql.DB, writer io.Writer) *combinedWriter {
 }
 
 func (w *combinedWriter) Write(p []byte) (n int, err error) {
-	n, err = w.originalWriter.Write(p)
-	if err != nil {
-		return
-	}
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
 
+// store queues the event to be stored in the database
+func (w *combinedWriter) store(p []byte) error {
 	var evt map[string]interface{}
 	p = decodeIfBinaryToBytes(p)
 	d := json.NewDecoder(bytes.NewReader(p))
 	d.UseNumber()
-	err = d.Decode(&evt)
-	if err != nil {
-		return
+	if err := d.Decode(&evt); err != nil {
+		metrics.LogDropped.WithLabelValues("decode").Inc()
+		return err
 	}
-	w.eventStream <- evt
 
-	return
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	if w.closed {
+		metrics.LogDropped.WithLabelValues("stopped").Inc()
+		return nil
+	}
+
+	w.enqueue(evt)
+
+	return nil
 }
 
+// Stop closes the queue and waits until the queued events are stored, the
+// ones not stored within stopTimeout are dropped
 func (w *combinedWriter) Stop() {
-	close(w.eventStream)
+	w.lock.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.eventStream)
+	}
+	w.lock.Unlock()
+
+	timer := time.AfterFunc(stopTimeout, w.cancelDrain)
+	defer timer.Stop()
+
+	<-w.done
 }
 
 func (l *Logger) Stop() {
 	l.w.Stop()
 }
 
-func (l *Logger) Level(level string) error {
-	lvl, err := zerolog.ParseLevel(level)
-	if err != nil {
-		return err
-	}
-	newLogger := l.Logger.Level(lvl)
-	l.Logger = &newLogger
-	return nil
-}
-
 func (w *combinedWriter) storeEvent() {
+	defer close(w.done)
+	defer w.cancelDrain()
+
 	for evt := range w.eventStream {
+		metrics.LogQueueDepth.Dec()
+
+		if w.drain.Err() != nil {
+			metrics.LogDropped.WithLabelValues("stopped").Inc()
+			continue
+		}
+
 		connectionId, ok := evt[ConnectionIdFieldName].(string)
 		if !ok {
+			metrics.LogDropped.WithLabelValues("invalid").Inc()
 			log.Error().Err(fmt.Errorf("cannot extract connection_id from event")).Send()
 			continue
 		}
 
 		timestamp, ok := evt[zerolog.TimestampFieldName].(string)
 		if !ok {
+			metrics.LogDropped.WithLabelValues("invalid").Inc()
 			log.Error().Err(fmt.Errorf("cannot extract timestamp from event")).Interface("raw", evt).Send()
 			continue
 		}
 
 		level, ok := evt[zerolog.LevelFieldName].(string)
 		if !ok {
+			metrics.LogDropped.WithLabelValues("invalid").Inc()
 			log.Error().Err(fmt.Errorf("cannot extract level from event")).Send()
 			continue
 		}
@@ -131,6 +191,7 @@ func (w *combinedWriter) storeEvent() {
 
 		t, err := time.Parse(time.RFC3339Nano, timestamp)
 		if err != nil {
+			metrics.LogDropped.WithLabelValues("invalid").Inc()
 			log.Error().Err(fmt.Errorf("cannot parse timestamp: %s", err)).Send()
 			continue
 		}
@@ -138,21 +199,44 @@ func (w *combinedWriter) storeEvent() {
 		cleanupEvent(evt)
 		message, err := json.Marshal(evt)
 		if err != nil {
+			metrics.LogDropped.WithLabelValues("invalid").Inc()
 			log.Error().Err(fmt.Errorf("cannot marshal event: %s", err)).Send()
 			continue
 		}
 
-		l := models.Log{
+		l := &models.Log{
 			Client:    connectionId,
 			Level:     level,
 			Timestamp: null.TimeFrom(t),
 			Message:   null.StringFrom(string(message)),
 			Label:     label,
 		}
-		err = l.Insert(context.Background(), w.db, boil.Infer())
-		if err != nil {
-			log.Error().Err(err).Msg("failed to insert log into db")
-		}
+		w.insert(l)
+	}
+}
+
+// insert queues the entry for the database, waiting at most insertTimeout while
+// the ingest queue is full. Write applies the overflow policy meanwhile.
+func (w *combinedWriter) insert(l *models.Log) {
+	ctx, cancel := context.WithTimeout(w.drain, insertTimeout)
+	defer cancel()
+
+	err := w.ingest.InsertLog(ctx, l, logInserted)
+	switch {
+	case err == nil:
+	case errors.Is(err, context.DeadlineExceeded):
+		metrics.LogDropped.WithLabelValues("queue_full").Inc()
+	case errors.Is(err, context.Canceled), errors.Is(err, ingest.ErrClosed):
+		metrics.LogDropped.WithLabelValues("stopped").Inc()
+	default:
+		logInserted(err)
+	}
+}
+
+func logInserted(err error) {
+	if err != nil {
+		metrics.LogDropped.WithLabelValues("db").Inc()
+		log.Error().Err(err).Msg("failed to insert log into db")
 	}
 }
 
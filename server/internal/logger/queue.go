@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
+)
+
+const (
+	// OverflowDropOldest drops the oldest queued event to make room for the new one
+	OverflowDropOldest = "drop_oldest"
+	// OverflowSample keeps one event out of SampleRate while the queue is full,
+	// replacing the oldest queued one, the others are dropped
+	OverflowSample = "sample"
+)
+
+type Options struct {
+	// QueueSize bounds the events of a connection waiting to be stored
+	QueueSize int
+	// Overflow is the policy applied when the queue is full
+	Overflow   string
+	SampleRate int
+}
+
+var options atomic.Pointer[Options]
+
+func init() {
+	options.Store(&Options{
+		QueueSize:  1024,
+		Overflow:   OverflowDropOldest,
+		SampleRate: 10,
+	})
+}
+
+// Configure sets options of loggers created from now on
+func Configure(o Options) error {
+	switch o.Overflow {
+	case OverflowDropOldest, OverflowSample:
+	default:
+		return fmt.Errorf("unknown overflow policy %q, expected %s or %s", o.Overflow, OverflowDropOldest, OverflowSample)
+	}
+	if o.QueueSize <= 0 {
+		return fmt.Errorf("queue size must be positive, got %d", o.QueueSize)
+	}
+	o.SampleRate = max(o.SampleRate, 1)
+
+	options.Store(&o)
+	return nil
+}
+
+// enqueue queues the event without blocking, making room according to the
+// overflow policy if the queue is full. Must be called with w.lock held.
+func (w *combinedWriter) enqueue(evt map[string]any) {
+	for attempt := 0; ; attempt++ {
+		select {
+		case w.eventStream <- evt:
+			metrics.LogQueueDepth.Inc()
+			return
+		default:
+		}
+
+		if attempt == 0 && w.opts.Overflow == OverflowSample &&
+			w.overflows.Add(1)%uint64(w.opts.SampleRate) != 0 {
+			metrics.LogDropped.WithLabelValues("queue_full").Inc()
+			return
+		}
+
+		// concurrent writers may fill the slot first, so the oldest event is
+		// dropped until the new one fits
+		select {
+		case <-w.eventStream:
+			metrics.LogQueueDepth.Dec()
+			metrics.LogDropped.WithLabelValues("queue_full").Inc()
+		default:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// secretKeys are JSON keys whose values never make it to logs, compared lower cased
+var secretKeys = map[string]struct{}{
+	"password":      {},
+	"privatekey":    {},
+	"private_key":   {},
+	"secret":        {},
+	"token":         {},
+	"authorization": {},
+	"api_key":       {},
+	"apikey":        {},
+}
+
+// redacted marshals the value to JSON with secret fields replaced
+type redacted struct {
+	v any
+}
+
+// Redact wraps the value for zerolog's Interface so that fields named like
+// secrets are masked at any depth, including inside JSON columns
+func Redact(v any) any {
+	return redacted{v: v}
+}
+
+func (r redacted) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(r.v)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(scrub(doc))
+}
+
+func scrub(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if _, ok := secretKeys[strings.ToLower(k)]; ok {
+				if val != nil && val != "" {
+					v[k] = redactedValue
+				}
+				continue
+			}
+			v[k] = scrub(val)
+		}
+	case []any:
+		for i := range v {
+			v[i] = scrub(v[i])
+		}
+	}
+	return v
+}
@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	TraceIDFieldName = "trace_id"
+	SpanIDFieldName  = "span_id"
+)
+
+// traceHook adds the IDs of the span in the event context, set with Ctx, so that
+// logs can be matched with traces
+type traceHook struct{}
+
+func (traceHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	ctx := e.GetCtx()
+	if ctx == nil {
+		return
+	}
+
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	e.Str(TraceIDFieldName, sc.TraceID().String()).Str(SpanIDFieldName, sc.SpanID().String())
+}
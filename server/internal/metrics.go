@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
+)
+
+// startMetrics serves the Prometheus metrics if a listen address is configured
+func (a *App) startMetrics() {
+	specs := a.cfg.Specs.Metrics
+	if specs.Listen == "" {
+		return
+	}
+
+	if err := metrics.RegisterDB(a.cfg.DB(), "pxgrider"); err != nil {
+		a.cfg.Logger().Error().Err(err).Msg("Failed to register DB metrics")
+	}
+	if err := metrics.RegisterSubscriptions(a.subscriptionStates); err != nil {
+		a.cfg.Logger().Error().Err(err).Msg("Failed to register subscription metrics")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(specs.Path, metrics.Handler())
+	a.metrics = &http.Server{
+		Addr:              specs.Listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		a.cfg.Logger().Info().Str("address", specs.Listen).Str("path", specs.Path).Msg("Serving metrics")
+		if err := a.metrics.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.cfg.Logger().Error().Err(err).Msg("Metrics listener failed")
+		}
+	}()
+}
+
+func (a *App) subscriptionStates() []metrics.SubscriptionState {
+	var res []metrics.SubscriptionState
+	for _, c := range a.users.AllConnections() {
+		id := c.ID()
+		for _, s := range c.AllSubscriptions() {
+			res = append(res, metrics.SubscriptionState{
+				ConnectionID: id,
+				Service:      s.Service,
+				Topic:        s.Topic,
+				Connected:    s.Connected(),
+			})
+		}
+	}
+	return res
+}
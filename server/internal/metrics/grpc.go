@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor counts and times requests, it should run first to
+// include rejected ones
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observe(info.FullMethod, start, err)
+	return resp, err
+}
+
+func StreamServerInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	observe(info.FullMethod, start, err)
+	return err
+}
+
+func observe(method string, start time.Time, err error) {
+	GRPCDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	GRPCHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+}
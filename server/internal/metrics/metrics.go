@@ -0,0 +1,179 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "pxgrider"
+
+type (
+	// SubscriptionState is reported by the subscriptions collector on every scrape
+	SubscriptionState struct {
+		ConnectionID string
+		Service      string
+		Topic        string
+		Connected    bool
+	}
+
+	subscriptionsCollector struct {
+		states func() []SubscriptionState
+	}
+)
+
+// Registry holds every metric of the server, it's exposed by Handler
+var Registry = prometheus.NewRegistry()
+
+var (
+	GRPCHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "gRPC requests handled, by method and status code.",
+	}, []string{"method", "code"})
+
+	GRPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of gRPC requests, streams last until closed.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	MessagesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "messages",
+		Name:      "received_total",
+		Help:      "pxGrid messages received by subscriptions.",
+	}, []string{"connection", "service", "topic"})
+
+	MessagesPersisted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "messages",
+		Name:      "persisted_total",
+		Help:      "pxGrid messages stored in the database.",
+	}, []string{"connection", "service", "topic"})
+
+	RESTDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rest",
+		Name:      "call_duration_seconds",
+		Help:      "Duration of pxGrid REST calls by service, method and HTTP status, \"error\" if the call failed.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method", "status"})
+
+	LogQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "log_writer",
+		Name:      "queue_depth",
+		Help:      "Connection log events waiting to be stored in the database.",
+	})
+
+	LogDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "log_writer",
+		Name:      "dropped_total",
+		Help:      "Connection log events which weren't stored, by reason.",
+	}, []string{"reason"})
+
+	IngestQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "queue_depth",
+		Help:      "Rows waiting to be inserted, by table.",
+	}, []string{"table"})
+
+	IngestBatchRows = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "batch_rows",
+		Help:      "Rows inserted by a batch, by table.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+	}, []string{"table"})
+
+	IngestFlushDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "flush_duration_seconds",
+		Help:      "Duration of batch inserts including retries, by table.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"table"})
+
+	IngestFlushErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "flush_errors_total",
+		Help:      "Failed batch inserts, retried while the database is unavailable, by table.",
+	}, []string{"table"})
+
+	IngestDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      "dropped_total",
+		Help:      "Rows which weren't inserted, by table and reason.",
+	}, []string{"table", "reason"})
+
+	subscriptionConnectedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "subscription", "connected"),
+		"Whether the subscription is connected to its pubsub node.",
+		[]string{"connection", "service", "topic"}, nil,
+	)
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		GRPCHandled,
+		GRPCDuration,
+		MessagesReceived,
+		MessagesPersisted,
+		RESTDuration,
+		LogQueueDepth,
+		LogDropped,
+		IngestQueueDepth,
+		IngestBatchRows,
+		IngestFlushDuration,
+		IngestFlushErrors,
+		IngestDropped,
+	)
+}
+
+// RegisterDB reports the pool stats of the database
+func RegisterDB(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterSubscriptions reports the states returned by the function on every scrape
+func RegisterSubscriptions(states func() []SubscriptionState) error {
+	return Registry.Register(&subscriptionsCollector{states: states})
+}
+
+// DeleteConnection drops the series of a deleted connection
+func DeleteConnection(id string) {
+	MessagesReceived.DeletePartialMatch(prometheus.Labels{"connection": id})
+	MessagesPersisted.DeletePartialMatch(prometheus.Labels{"connection": id})
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+func (c *subscriptionsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- subscriptionConnectedDesc
+}
+
+func (c *subscriptionsCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.states() {
+		v := 0.0
+		if s.Connected {
+			v = 1
+		}
+		ch <- prometheus.MustNewConstMetric(subscriptionConnectedDesc, prometheus.GaugeValue, v,
+			s.ConnectionID, s.Service, s.Topic)
+	}
+}
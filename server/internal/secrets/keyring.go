@@ -0,0 +1,274 @@
+package secrets
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	envelopeVersion = 1
+	keySize         = 32
+)
+
+var (
+	ErrNoKeyring  = errors.New("stored secret is encrypted but no keys are configured")
+	ErrUnknownKey = errors.New("stored secret is encrypted with an unknown key")
+	ErrPlaintext  = errors.New("no encryption keys are configured and secrets are required to be encrypted")
+)
+
+type (
+	// Keyring holds the master keys by id. Secrets are encrypted with a random
+	// data key, which is encrypted with the primary master key and stored in the
+	// envelope along with the id of the master key.
+	Keyring struct {
+		keys    map[string][]byte
+		primary string
+	}
+
+	envelope struct {
+		Version int    `json:"enc"`
+		KeyID   string `json:"kid"`
+		DataKey []byte `json:"dek"`
+		Data    []byte `json:"data"`
+	}
+)
+
+var (
+	keyring           atomic.Pointer[Keyring]
+	requireEncryption atomic.Bool
+)
+
+// Configure sets the keyring used by Seal and Open, nil disables encryption
+func Configure(k *Keyring) {
+	keyring.Store(k)
+}
+
+// RequireEncryption makes Seal fail with ErrPlaintext when encryption is
+// disabled, by default documents are returned as is
+func RequireEncryption(require bool) {
+	requireEncryption.Store(require)
+}
+
+// Current returns the configured keyring, nil if encryption is disabled
+func Current() *Keyring {
+	return keyring.Load()
+}
+
+// NewKeyring creates a keyring encrypting with the primary key, the last of the
+// keys if primary is empty
+func NewKeyring(keys map[string][]byte, order []string, primary string) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("keyring requires at least one key")
+	}
+	for id, k := range keys {
+		if len(k) != keySize {
+			return nil, fmt.Errorf("key %q must be %d bytes, got %d", id, keySize, len(k))
+		}
+	}
+	if primary == "" && len(order) > 0 {
+		primary = order[len(order)-1]
+	}
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("primary key %q is not in the keyring", primary)
+	}
+
+	return &Keyring{keys: keys, primary: primary}, nil
+}
+
+// ParseKeys reads "<id>:<base64 key>" entries separated by commas or new lines,
+// empty lines and lines starting with # are skipped. It returns the ids in order.
+func ParseKeys(s string, into map[string][]byte) ([]string, error) {
+	var order []string
+	sc := bufio.NewScanner(strings.NewReader(strings.ReplaceAll(s, ",", "\n")))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(line, ":")
+		if !ok || id == "" {
+			return nil, errors.New("key must be formatted as <id>:<base64 key>")
+		}
+		if _, ok := into[id]; ok {
+			return nil, fmt.Errorf("duplicate key %q", id)
+		}
+		k, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64: %w", id, err)
+		}
+		into[id] = k
+		order = append(order, id)
+	}
+
+	return order, sc.Err()
+}
+
+// LoadKeyring builds the keyring from the inline keys followed by the keys of the
+// file, it returns nil if neither is set
+func LoadKeyring(inline, file, primary string) (*Keyring, error) {
+	keys := make(map[string][]byte)
+	order, err := ParseKeys(inline, keys)
+	if err != nil {
+		return nil, err
+	}
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		fromFile, err := ParseKeys(string(data), keys)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		order = append(order, fromFile...)
+	}
+	if len(keys) == 0 {
+		if primary != "" {
+			return nil, errors.New("primary key is set but no keys are configured")
+		}
+		return nil, nil
+	}
+
+	return NewKeyring(keys, order, primary)
+}
+
+// GenerateKey returns a new master key encoded for ParseKeys
+func GenerateKey() (string, error) {
+	k := make([]byte, keySize)
+	if _, err := rand.Read(k); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(k), nil
+}
+
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// Seal encrypts the JSON document with the primary key, the result is a JSON
+// document as well. aad binds the ciphertext to its owner, Open must be given
+// the same.
+func (k *Keyring) Seal(plain, aad []byte) ([]byte, error) {
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, err
+	}
+
+	data, err := encrypt(dek, plain, aad)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := encrypt(k.keys[k.primary], dek, []byte(k.primary))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(envelope{Version: envelopeVersion, KeyID: k.primary, DataKey: wrapped, Data: data})
+}
+
+// Open decrypts a document sealed by any key of the keyring, documents which
+// aren't sealed are returned as is
+func (k *Keyring) Open(data, aad []byte) ([]byte, error) {
+	env, ok := parseEnvelope(data)
+	if !ok {
+		return data, nil
+	}
+	if k == nil {
+		return nil, ErrNoKeyring
+	}
+
+	master, ok := k.keys[env.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, env.KeyID)
+	}
+	dek, err := decrypt(master, env.DataKey, []byte(env.KeyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+
+	return decrypt(dek, env.Data, aad)
+}
+
+// KeyID returns the id of the key the document is sealed with, false if it isn't sealed
+func KeyID(data []byte) (string, bool) {
+	env, ok := parseEnvelope(data)
+	if !ok {
+		return "", false
+	}
+	return env.KeyID, true
+}
+
+// Seal encrypts with the configured keyring. If encryption is disabled the
+// document is returned as is, unless encryption is required.
+func Seal(plain, aad []byte) ([]byte, error) {
+	k := keyring.Load()
+	if k == nil {
+		if requireEncryption.Load() {
+			return nil, ErrPlaintext
+		}
+		return plain, nil
+	}
+	return k.Seal(plain, aad)
+}
+
+// Open decrypts with the configured keyring
+func Open(data, aad []byte) ([]byte, error) {
+	return keyring.Load().Open(data, aad)
+}
+
+func parseEnvelope(data []byte) (*envelope, bool) {
+	if !bytes.Contains(data, []byte(`"enc"`)) {
+		return nil, false
+	}
+
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil || env.Version != envelopeVersion || env.KeyID == "" {
+		return nil, false
+	}
+	return &env, true
+}
+
+func encrypt(key, plain, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plain)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plain, aad), nil
+}
+
+func decrypt(key, data, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], aad)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+
+	"github.com/vkumov/go-pxgrider/server/internal/audit"
+)
+
+func (s *server) GetAuditEvents(ctx context.Context, req *pb.GetAuditEventsRequest) (*pb.GetAuditEventsResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().GetUid()).Str("id", req.GetConnectionId()).Msg("GetAuditEvents")
+
+	f := audit.Filter{
+		UID:          req.GetUser().GetUid(),
+		ConnectionID: req.GetConnectionId(),
+		Actor:        req.GetActor(),
+		Method:       req.GetMethod(),
+		ErrorsOnly:   req.GetErrorsOnly(),
+		Limit:        req.GetLimit(),
+		Offset:       req.GetOffset(),
+	}
+	if req.GetSince() != nil {
+		f.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		f.Until = req.GetUntil().AsTime()
+	}
+
+	events, total, err := s.app.Audit().Query(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetAuditEventsResponse{
+		Events: make([]*pb.AuditEvent, 0, len(events)),
+		Total:  total,
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+	for _, e := range events {
+		res.Events = append(res.Events, e.ToProto())
+	}
+
+	return res, nil
+}
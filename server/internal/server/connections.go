@@ -10,7 +10,10 @@ import (
 	gopxgrid "github.com/vkumov/go-pxgrid"
 
 	pb "github.com/vkumov/go-pxgrider/pkg"
+	"github.com/vkumov/go-pxgrider/server/internal/audit"
+	"github.com/vkumov/go-pxgrider/server/internal/auth"
 	"github.com/vkumov/go-pxgrider/server/internal/connection"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
 )
 
 func (s *server) GetConnections(ctx context.Context, req *pb.GetConnectionsRequest) (*pb.GetConnectionsResponse, error) {
@@ -144,6 +147,12 @@ func (s *server) GetConnection(ctx context.Context, req *pb.GetConnectionRequest
 
 func (s *server) UpdateConnection(ctx context.Context, req *pb.UpdateConnectionRequest) (*pb.UpdateConnectionResponse, error) {
 	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.Id).Msg("UpdateConnection")
+	if req.GetCredentials() != nil || req.GetOwner() != nil {
+		if err := auth.Require(ctx, auth.RoleAdmin); err != nil {
+			return nil, err
+		}
+	}
+
 	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.Id)
 	if err != nil {
 		return nil, err
@@ -248,9 +257,19 @@ func (s *server) UpdateConnection(ctx context.Context, req *pb.UpdateConnectionR
 		upd.CA = sql.Null[[]string]{V: v.Value.GetStrings(), Valid: true}
 	}
 
-	if err = c.Update(ctx, upd); err != nil {
+	switch v := req.GetRetention().GetKind().(type) {
+	case *pb.NullableRetention_Value:
+		upd.Retention = sql.Null[connection.RetentionOverride]{V: connection.RetentionOverrideFromProto(v.Value), Valid: true}
+	case *pb.NullableRetention_Null:
+		upd.Retention = sql.Null[connection.RetentionOverride]{Valid: true}
+	}
+
+	changes, err := c.Update(ctx, upd)
+	if err != nil {
 		return nil, err
 	}
+	audit.Set(ctx, "changes", changes)
+
 	return &pb.UpdateConnectionResponse{}, nil
 }
 
@@ -268,9 +287,67 @@ func (s *server) DeleteConnection(ctx context.Context, req *pb.DeleteConnectionR
 	return &pb.DeleteConnectionResponse{}, nil
 }
 
-func (s *server) RefreshConnection(context.Context, *pb.RefreshConnectionRequest) (*pb.RefreshConnectionResponse, error) {
-	// FIXME: implement
-	return nil, errors.New("not implemented")
+func (s *server) GetConnectionSinks(ctx context.Context, req *pb.GetConnectionSinksRequest) (*pb.GetConnectionSinksResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.GetConnectionId()).Msg("GetConnectionSinks")
+	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetConnectionSinksResponse{Sinks: forwarder.ConfigsToProto(c.ConnectionSinks())}, nil
+}
+
+func (s *server) SetConnectionSinks(ctx context.Context, req *pb.SetConnectionSinksRequest) (*pb.SetConnectionSinksResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.GetConnectionId()).Msg("SetConnectionSinks")
+	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	sinks, err := forwarder.ConfigsFromProto(req.GetSinks())
+	if err != nil {
+		return nil, err
+	}
+
+	sinks, err = c.SetConnectionSinks(ctx, sinks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetConnectionSinksResponse{Sinks: forwarder.ConfigsToProto(sinks)}, nil
+}
+
+func (s *server) SetConnectionLogLevel(ctx context.Context, req *pb.SetConnectionLogLevelRequest) (*pb.SetConnectionLogLevelResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.GetConnectionId()).Msg("SetConnectionLogLevel")
+	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := c.SetLogLevel(ctx, connection.LogLevel{
+		Stdout: req.GetStdoutLevel(),
+		DB:     req.GetDbLevel(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetConnectionLogLevelResponse{LogLevel: level.ToProto()}, nil
+}
+
+func (s *server) RefreshConnection(ctx context.Context, req *pb.RefreshConnectionRequest) (*pb.RefreshConnectionResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Msg("RefreshConnection")
+	u := s.app.Users().GetUser(ctx, req.GetUser().Uid)
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+
+	results, err := u.Refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RefreshConnectionResponse{Results: results.ToProto()}, nil
 }
 
 func (s *server) RefreshAccountState(ctx context.Context, req *pb.RefreshAccountStateRequest) (*pb.RefreshAccountStateResponse, error) {
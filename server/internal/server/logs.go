@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	pb "github.com/vkumov/go-pxgrider/pkg"
+	"github.com/vkumov/go-pxgrider/server/internal/connection"
 )
 
 func (s *server) GetConnectionLogs(ctx context.Context, req *pb.GetConnectionLogsRequest) (*pb.GetConnectionLogsResponse, error) {
@@ -16,21 +17,28 @@ func (s *server) GetConnectionLogs(ctx context.Context, req *pb.GetConnectionLog
 	limit := req.GetLimit()
 	offset := req.GetOffset()
 
-	logs, err := c.GetLogs(ctx, limit, offset)
+	logs, next, err := c.GetLogs(ctx, connection.Page{Limit: limit, Offset: offset, Cursor: req.GetCursor()})
 	if err != nil {
 		return nil, err
 	}
 
-	total, err := c.GetLogsCount(ctx)
+	var total int64
+	if req.GetApproximateTotal() {
+		total, err = c.EstimateLogsCount(ctx)
+	} else {
+		total, err = c.GetLogsCount(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetConnectionLogsResponse{
-		ConnectionLogs: logs.ToProto(),
-		Total:          total,
-		Limit:          limit,
-		Offset:         offset,
+		ConnectionLogs:   logs.ToProto(),
+		Total:            total,
+		Limit:            limit,
+		Offset:           offset,
+		NextCursor:       next,
+		TotalApproximate: req.GetApproximateTotal(),
 	}, nil
 }
 
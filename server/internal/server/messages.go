@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	pb "github.com/vkumov/go-pxgrider/pkg"
+	"github.com/vkumov/go-pxgrider/server/internal/connection"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
 )
 
 func (s *server) GetConnectionMessages(ctx context.Context, req *pb.GetConnectionMessagesRequest) (*pb.GetConnectionMessagesResponse, error) {
@@ -16,21 +18,34 @@ func (s *server) GetConnectionMessages(ctx context.Context, req *pb.GetConnectio
 	limit := req.GetLimit()
 	offset := req.GetOffset()
 
-	msgs, err := c.GetMessages(ctx, limit, offset)
+	filter, err := connection.MessagesFilterFromProto(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
 
-	total, err := c.GetMessagesCount(ctx)
+	msgs, next, err := c.GetMessages(ctx, filter, connection.Page{Limit: limit, Offset: offset, Cursor: req.GetCursor()})
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	approximate := req.GetApproximateTotal()
+	if approximate {
+		total, err = c.EstimateMessagesCount(ctx, filter)
+	} else {
+		total, approximate, err = c.GetMessagesCount(ctx, filter)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetConnectionMessagesResponse{
-		Messages: msgs.ToProto(),
-		Total:    total,
-		Limit:    limit,
-		Offset:   offset,
+		Messages:         msgs.ToProto(),
+		Total:            total,
+		Limit:            limit,
+		Offset:           offset,
+		NextCursor:       next,
+		TotalApproximate: approximate,
 	}, nil
 }
 
@@ -72,3 +87,122 @@ func (s *server) DeleteConnectionMessages(ctx context.Context, req *pb.DeleteCon
 
 	return &pb.DeleteConnectionMessagesResponse{Deleted: deleted}, nil
 }
+
+func (s *server) StreamConnectionMessages(req *pb.StreamConnectionMessagesRequest, stream pb.PxgriderService_StreamConnectionMessagesServer) error {
+	ctx := stream.Context()
+	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.GetConnectionId()).
+		Str("service", req.GetService()).Str("topic", req.GetTopic()).Int64("resume_from_id", req.GetResumeFromId()).
+		Msg("StreamConnectionMessages")
+	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.GetConnectionId())
+	if err != nil {
+		return err
+	}
+
+	topic := connection.TopicName(req.GetTopic())
+
+	// start listening before reading the backlog, so nothing is lost in between
+	l, err := c.ListenMessages(req.GetService(), topic)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	send := func(service string, m *models.Message) error {
+		return stream.Send(&pb.StreamConnectionMessagesResponse{
+			Message: connection.MessageSlice{m}.ToProto()[0],
+			Service: service,
+		})
+	}
+
+	lastID := req.GetResumeFromId()
+	if lastID > 0 {
+		err = c.GetMessagesBacklog(ctx, req.GetService(), topic, lastID, func(service string, m *models.Message) error {
+			if err := send(service, m); err != nil {
+				return err
+			}
+			lastID = m.ID
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt, ok := <-l.C:
+			if !ok {
+				return l.Err()
+			}
+			if evt.Message.ID <= lastID {
+				continue
+			}
+			if err := send(evt.Service, evt.Message); err != nil {
+				return err
+			}
+			lastID = evt.Message.ID
+		}
+	}
+}
+
+const exportChunkSize = 64 << 10
+
+// exportStream buffers the exported file and sends it in chunks
+type exportStream struct {
+	stream pb.PxgriderService_ExportConnectionMessagesServer
+	buf    []byte
+}
+
+func (e *exportStream) Write(p []byte) (int, error) {
+	e.buf = append(e.buf, p...)
+	for len(e.buf) >= exportChunkSize {
+		if err := e.stream.Send(&pb.ExportConnectionMessagesResponse{Chunk: e.buf[:exportChunkSize]}); err != nil {
+			return 0, err
+		}
+		e.buf = e.buf[exportChunkSize:]
+	}
+
+	return len(p), nil
+}
+
+func (s *server) ExportConnectionMessages(req *pb.ExportConnectionMessagesRequest, stream pb.PxgriderService_ExportConnectionMessagesServer) error {
+	ctx := stream.Context()
+	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.GetConnectionId()).
+		Str("format", req.GetFormat().String()).Msg("ExportConnectionMessages")
+	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.GetConnectionId())
+	if err != nil {
+		return err
+	}
+
+	filter, err := connection.MessagesFilterFromProto(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	var format connection.ExportFormat
+	switch req.GetFormat() {
+	case pb.ExportFormat_EXPORT_FORMAT_NDJSON:
+		format = connection.ExportNDJSON
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		format = connection.ExportCSV
+	case pb.ExportFormat_EXPORT_FORMAT_PARQUET:
+		format = connection.ExportParquet
+	default:
+		return errors.New("unknown export format")
+	}
+
+	var columns []string
+	if len(req.GetCsvColumns()) > 0 {
+		columns = req.GetCsvColumns()
+	}
+
+	w := &exportStream{stream: stream}
+	total, err := c.ExportMessages(ctx, filter, format, columns, w)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.ExportConnectionMessagesResponse{Chunk: w.buf, Total: total})
+}
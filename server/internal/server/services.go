@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	pb "github.com/vkumov/go-pxgrider/pkg"
 	"github.com/vkumov/go-pxgrider/server/internal/connection"
 	"github.com/vkumov/go-pxgrider/server/internal/connection/mappings"
+	"github.com/vkumov/go-pxgrider/server/internal/tracing"
 )
 
 var (
@@ -134,7 +137,9 @@ func (s *server) ServiceCheckNodes(ctx context.Context, req *pb.ServiceCheckNode
 		return nil, err
 	}
 
+	ctx, span := tracing.Start(ctx, "pxgrid.check_nodes", serviceAttrs(req.GetConnectionId(), sname)...)
 	err = svc.CheckNodes(ctx)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +164,9 @@ func (s *server) ServiceLookup(ctx context.Context, req *pb.ServiceLookupRequest
 	}
 
 	s.app.Log().Debug().Str("service", sname).Msg("Lookup service")
+	ctx, span := tracing.Start(ctx, "pxgrid.lookup", serviceAttrs(req.GetConnectionId(), sname)...)
 	err = svc.Lookup(ctx)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -190,10 +197,19 @@ func (s *server) ServiceUpdateSecrets(ctx context.Context, req *pb.ServiceUpdate
 		return nil, err
 	}
 
+	ctx, span := tracing.Start(ctx, "pxgrid.update_secrets", serviceAttrs(req.GetConnectionId(), sname)...)
 	err = svc.UpdateSecrets(ctx)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ServiceUpdateSecretsResponse{}, nil
 }
+
+func serviceAttrs(connectionID, service string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("pxgrid.connection", connectionID),
+		attribute.String("pxgrid.service", service),
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	pxgrider_proto "github.com/vkumov/go-pxgrider/pkg"
 	"github.com/vkumov/go-pxgrider/server/internal/connection"
+	"github.com/vkumov/go-pxgrider/server/internal/forwarder"
 )
 
 func (s *server) GetSubscription(ctx context.Context, req *pxgrider_proto.GetSubscriptionRequest) (*pxgrider_proto.GetSubscriptionResponse, error) {
@@ -30,8 +31,13 @@ func (s *server) SubscribeConnection(ctx context.Context, req *pxgrider_proto.Su
 		return nil, err
 	}
 
+	sinks, err := forwarder.ConfigsFromProto(req.GetSinks())
+	if err != nil {
+		return nil, err
+	}
+
 	s.app.Log().Debug().Str("service", req.GetService()).Str("topic", req.GetTopic()).Msg("Subscribe")
-	sub, err := c.Subscribe(ctx, req.Service, connection.TopicName(req.Topic))
+	sub, err := c.Subscribe(ctx, req.Service, connection.TopicName(req.Topic), sinks)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +45,26 @@ func (s *server) SubscribeConnection(ctx context.Context, req *pxgrider_proto.Su
 	return &pxgrider_proto.SubscribeConnectionResponse{Subscription: sub.ToProto()}, nil
 }
 
+func (s *server) SetSubscriptionSinks(ctx context.Context, req *pxgrider_proto.SetSubscriptionSinksRequest) (*pxgrider_proto.SetSubscriptionSinksResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.GetConnectionId()).Msg("SetSubscriptionSinks")
+	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	sinks, err := forwarder.ConfigsFromProto(req.GetSinks())
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := c.SetSinks(ctx, req.GetService(), connection.TopicName(req.GetTopic()), sinks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pxgrider_proto.SetSubscriptionSinksResponse{Subscription: sub.ToProto()}, nil
+}
+
 func (s *server) UnsubscribeConnection(ctx context.Context, req *pxgrider_proto.UnsubscribeConnectionRequest) (*pxgrider_proto.UnsubscribeConnectionResponse, error) {
 	s.app.Log().Debug().Str("uid", req.GetUser().Uid).Str("id", req.GetConnectionId()).Msg("UnsubscribeConnection")
 	_, c, err := s.getUserConnection(ctx, req.GetUser().Uid, req.GetConnectionId())
@@ -47,7 +73,7 @@ func (s *server) UnsubscribeConnection(ctx context.Context, req *pxgrider_proto.
 	}
 
 	s.app.Log().Debug().Str("service", req.GetService()).Str("topic", req.GetTopic()).Msg("Unsubscribe")
-	err = c.Unsubscribe(req.Service, connection.TopicName(req.Topic))
+	err = c.Unsubscribe(ctx, req.Service, connection.TopicName(req.Topic))
 	if err != nil {
 		return nil, err
 	}
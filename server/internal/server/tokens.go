@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+
+	pb "github.com/vkumov/go-pxgrider/pkg"
+
+	"github.com/vkumov/go-pxgrider/server/internal/auth"
+)
+
+func (s *server) CreateApiToken(ctx context.Context, req *pb.CreateApiTokenRequest) (*pb.CreateApiTokenResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().GetUid()).Str("name", req.GetName()).Msg("CreateApiToken")
+	role, err := auth.RoleFromProto(req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	// tokens can't be given more than the caller has
+	if err := auth.Require(ctx, role); err != nil {
+		return nil, err
+	}
+
+	var expiresAt sql.NullTime
+	if req.GetExpiresAt() != nil {
+		expiresAt = sql.NullTime{Time: req.GetExpiresAt().AsTime(), Valid: true}
+	}
+
+	t, secret, err := s.app.Tokens().Create(ctx, req.GetUser().GetUid(), req.GetName(), role, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateApiTokenResponse{Token: t.ToProto(), Secret: secret}, nil
+}
+
+func (s *server) ListApiTokens(ctx context.Context, req *pb.ListApiTokensRequest) (*pb.ListApiTokensResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().GetUid()).Msg("ListApiTokens")
+	tokens, err := s.app.Tokens().List(ctx, req.GetUser().GetUid())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListApiTokensResponse{Tokens: make([]*pb.ApiToken, 0, len(tokens))}
+	for _, t := range tokens {
+		res.Tokens = append(res.Tokens, t.ToProto())
+	}
+
+	return res, nil
+}
+
+func (s *server) RevokeApiToken(ctx context.Context, req *pb.RevokeApiTokenRequest) (*pb.RevokeApiTokenResponse, error) {
+	s.app.Log().Debug().Str("uid", req.GetUser().GetUid()).Str("id", req.GetId()).Msg("RevokeApiToken")
+	if err := s.app.Tokens().Revoke(ctx, req.GetUser().GetUid(), req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeApiTokenResponse{}, nil
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Shutdown stops accepting calls, waits for running ones up to the shutdown
+// timeout, then stops every connection and flushes the ingest pipeline so that
+// messages, logs and unsaved fields are stored before the database is closed.
+// The flushes of the ingest pipeline and of the traces have their own timeouts,
+// connections slow to stop don't eat into them.
+func (a *App) Shutdown(parent context.Context) error {
+	ctx, cancel := context.WithTimeout(parent, a.cfg.Specs.Server.ShutdownTimeout)
+	defer cancel()
+
+	log := a.cfg.Logger()
+	start := time.Now()
+	close(a.stopping)
+
+	// health checks fail from now on so that balancers stop routing calls here
+	a.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Warn().Msg("Graceful stop timed out, cancelling running calls")
+		a.grpcServer.Stop()
+		<-stopped
+	}
+
+	var errs []error
+	if a.metrics != nil {
+		if err := a.metrics.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop metrics listener: %w", err))
+		}
+	}
+
+	if err := a.stopConnections(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	flushCtx, cancelFlush := context.WithTimeout(context.WithoutCancel(parent), a.cfg.Specs.Ingest.ShutdownTimeout)
+	defer cancelFlush()
+	if err := a.ingest.Close(flushCtx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to flush ingest pipeline: %w", err))
+	}
+
+	if a.certs != nil {
+		if err := a.certs.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop certificates reloader: %w", err))
+		}
+	}
+	// spans of the flush are exported too, so the provider flushes last with
+	// its own timeout
+	tracingCtx, cancelTracing := context.WithTimeout(context.WithoutCancel(parent), a.cfg.Specs.Tracing.ShutdownTimeout)
+	defer cancelTracing()
+	if err := a.tracing(tracingCtx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to flush traces: %w", err))
+	}
+	if err := a.cfg.DB().Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close database: %w", err))
+	}
+
+	log.Info().Dur("took", time.Since(start)).Msg("Server stopped")
+
+	return errors.Join(errs...)
+}
+
+// stopConnections flushes and stops every connection in parallel, connections
+// which didn't stop before ctx is done are left behind
+func (a *App) stopConnections(ctx context.Context) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, c := range a.users.AllConnections() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.Flush(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+			c.Stop()
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("failed to stop connections: %w", ctx.Err())
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return errors.Join(errs...)
+}
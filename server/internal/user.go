@@ -5,13 +5,19 @@ import (
 	"database/sql"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/rs/zerolog"
 
 	"github.com/vkumov/go-pxgrider/server/internal/connection"
+	pxdb "github.com/vkumov/go-pxgrider/server/internal/db"
 	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/ingest"
+	"github.com/vkumov/go-pxgrider/server/internal/metrics"
 	"github.com/vkumov/go-pxgrider/server/shared"
 )
 
@@ -22,18 +28,24 @@ type (
 		l           *zerolog.Logger
 		lw          io.Writer
 		db          *sql.DB
+		dialect     pxdb.Dialect
+		ingest      *ingest.Pipeline
 		lock        sync.RWMutex
 	}
 )
 
+const restoreTimeout = 2 * time.Minute
+
 var _ shared.UserHandler = (*user)(nil)
 
-func newUser(ctx context.Context, uid string, l *zerolog.Logger, db *sql.DB, lw io.Writer) *user {
+func newUser(ctx context.Context, uid string, l *zerolog.Logger, db *sql.DB, dialect pxdb.Dialect, pipeline *ingest.Pipeline, lw io.Writer) *user {
 	u := &user{
 		uid:         uid,
 		l:           l,
 		lw:          lw,
 		db:          db,
+		dialect:     dialect,
+		ingest:      pipeline,
 		connections: make(map[string]*connection.Connection),
 	}
 
@@ -58,16 +70,105 @@ func (u *user) LoadFromDB(ctx context.Context) error {
 	defer u.lock.Unlock()
 
 	for _, cl := range cls {
-		c := connection.New(u.db, cl.ID, u.uid, u.l, u.lw)
+		if _, ok := u.connections[cl.ID]; ok {
+			continue
+		}
+
+		c := connection.New(u.db, u.dialect, u.ingest, cl.ID, u.uid, u.l, u.lw)
 		if err := c.WithDBData(cl); err != nil {
 			return fmt.Errorf("failed to load connection %s for user %s: %w", cl.ID, u.uid, err)
 		}
 		u.connections[cl.ID] = c
+
+		go u.restoreSubscriptions(c)
 	}
 
 	return nil
 }
 
+func (u *user) restoreSubscriptions(c *connection.Connection) {
+	ctx, cancel := context.WithTimeout(context.Background(), restoreTimeout)
+	defer cancel()
+
+	if err := c.RestoreSubscriptions(ctx); err != nil {
+		u.l.Warn().Err(err).Str("id", c.ID()).Msg("Not all subscriptions were restored")
+	}
+}
+
+// Refresh re-reads the connections of the user from the database and converges
+// the in-memory state with it
+func (u *user) Refresh(ctx context.Context) (connection.RefreshResultSlice, error) {
+	cls, err := models.Clients(models.ClientWhere.Owner.EQ(u.uid)).All(ctx, u.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load clients for user %s: %w", u.uid, err)
+	}
+
+	results := make(connection.RefreshResultSlice, 0, len(cls))
+	existing := make(map[*connection.Connection]*models.Client)
+	// connections are stopped once the lock is released, Stop waits for their
+	// subscriptions, sinks and logs
+	var stopped []*connection.Connection
+
+	u.lock.Lock()
+	seen := make(map[string]struct{}, len(cls))
+	for _, cl := range cls {
+		seen[cl.ID] = struct{}{}
+
+		if c, ok := u.connections[cl.ID]; ok {
+			existing[c] = cl
+			continue
+		}
+
+		c := connection.New(u.db, u.dialect, u.ingest, cl.ID, u.uid, u.l, u.lw)
+		if err := c.WithDBData(cl); err != nil {
+			stopped = append(stopped, c)
+			results = append(results, connection.RefreshResult{
+				ConnectionID: cl.ID,
+				Action:       connection.RefreshActionAdded,
+				Err:          fmt.Errorf("failed to load connection %s: %w", cl.ID, err),
+			})
+			continue
+		}
+		u.connections[cl.ID] = c
+		go u.restoreSubscriptions(c)
+
+		results = append(results, connection.RefreshResult{ConnectionID: cl.ID, Action: connection.RefreshActionAdded})
+	}
+
+	for id, c := range u.connections {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		stopped = append(stopped, c)
+		delete(u.connections, id)
+		metrics.DeleteConnection(id)
+		results = append(results, connection.RefreshResult{ConnectionID: id, Action: connection.RefreshActionRemoved})
+	}
+	u.lock.Unlock()
+
+	for _, c := range stopped {
+		c.Stop()
+	}
+
+	for c, cl := range existing {
+		r := connection.RefreshResult{ConnectionID: cl.ID, Action: connection.RefreshActionUnchanged}
+		r.Changed, r.Err = c.ApplyDBData(ctx, cl)
+		if len(r.Changed) > 0 {
+			r.Action = connection.RefreshActionUpdated
+		}
+		results = append(results, r)
+	}
+
+	slices.SortFunc(results, func(a, b connection.RefreshResult) int {
+		return strings.Compare(a.ConnectionID, b.ConnectionID)
+	})
+
+	u.l.Debug().Str("uid", u.uid).Int("total", len(results)).Msg("Connections refreshed")
+
+	return results, nil
+}
+
 func (u *user) AddConnection(ctx context.Context, req connection.ConnectionCreate) (*connection.Connection, error) {
 	u.lock.Lock()
 	defer u.lock.Unlock()
@@ -77,7 +178,7 @@ func (u *user) AddConnection(ctx context.Context, req connection.ConnectionCreat
 		return nil, fmt.Errorf("failed to generate connection id: %w", err)
 	}
 
-	c, err := connection.NewWithRequest(u.db, id.String(), u.uid, req, u.l, u.lw)
+	c, err := connection.NewWithRequest(u.db, u.dialect, u.ingest, id.String(), u.uid, req, u.l, u.lw)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection: %w", err)
 	}
@@ -167,16 +268,18 @@ func (u *user) DeleteConnection(ctx context.Context, id string) error {
 	}
 
 	u.lock.Lock()
-	defer u.lock.Unlock()
-
 	c, ok := u.connections[id]
+	delete(u.connections, id)
+	u.lock.Unlock()
+
 	if !ok {
 		return nil
 	}
 
+	// Stop waits for the subscriptions, sinks and logs of the connection, other
+	// connections of the user stay reachable meanwhile
 	c.Stop()
-
-	delete(u.connections, id)
+	metrics.DeleteConnection(id)
 
 	return nil
 }
@@ -3,21 +3,29 @@ package internal
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"io"
 	"sync"
 
 	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
+	"github.com/vkumov/go-pxgrider/server/internal/connection"
+	pxdb "github.com/vkumov/go-pxgrider/server/internal/db"
+	"github.com/vkumov/go-pxgrider/server/internal/db/models"
+	"github.com/vkumov/go-pxgrider/server/internal/ingest"
 	"github.com/vkumov/go-pxgrider/server/internal/logger"
 	"github.com/vkumov/go-pxgrider/server/shared"
 )
 
 type Users struct {
-	users map[string]shared.UserHandler
-	l     *zerolog.Logger
-	lw    io.Writer
-	db    *sql.DB
-	lock  sync.Mutex
+	users   map[string]shared.UserHandler
+	l       *zerolog.Logger
+	lw      io.Writer
+	db      *sql.DB
+	dialect pxdb.Dialect
+	ingest  *ingest.Pipeline
+	lock    sync.Mutex
 }
 
 var _ shared.UsersHandler = (*Users)(nil)
@@ -28,17 +36,53 @@ func (u *Users) GetUser(ctx context.Context, username string) shared.UserHandler
 
 	if _, ok := u.users[username]; !ok {
 		log := u.l.With().Str(logger.UsernameFieldName, username).Logger()
-		u.users[username] = newUser(ctx, username, &log, u.db, u.lw)
+		u.users[username] = newUser(ctx, username, &log, u.db, u.dialect, u.ingest, u.lw)
 	}
 
 	return u.users[username]
 }
 
-func NewUsers(l shared.Logger, db shared.DBer) *Users {
+// LoadAll loads every user owning a connection, so persisted subscriptions
+// are restored without waiting for the first request of each user
+func (u *Users) LoadAll(ctx context.Context) error {
+	owners, err := models.Clients(qm.Distinct(models.ClientColumns.Owner)).All(ctx, u.db)
+	if err != nil {
+		return fmt.Errorf("failed to load connection owners: %w", err)
+	}
+
+	for _, o := range owners {
+		u.GetUser(ctx, o.Owner)
+	}
+
+	u.l.Info().Int("users", len(owners)).Msg("Users loaded")
+
+	return nil
+}
+
+// AllConnections returns connections of every loaded user
+func (u *Users) AllConnections() []*connection.Connection {
+	u.lock.Lock()
+	users := make([]shared.UserHandler, 0, len(u.users))
+	for _, usr := range u.users {
+		users = append(users, usr)
+	}
+	u.lock.Unlock()
+
+	var res []*connection.Connection
+	for _, usr := range users {
+		res = append(res, usr.GetConnections()...)
+	}
+
+	return res
+}
+
+func NewUsers(l shared.Logger, db shared.DBer, pipeline *ingest.Pipeline) *Users {
 	return &Users{
-		users: make(map[string]shared.UserHandler),
-		l:     l.Logger(),
-		lw:    l.LoggerWriter(),
-		db:    db.DB(),
+		users:   make(map[string]shared.UserHandler),
+		l:       l.Logger(),
+		lw:      l.LoggerWriter(),
+		db:      db.DB(),
+		dialect: db.Dialect(),
+		ingest:  pipeline,
 	}
 }
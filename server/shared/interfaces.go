@@ -6,7 +6,11 @@ import (
 	"io"
 
 	"github.com/rs/zerolog"
+
+	"github.com/vkumov/go-pxgrider/server/internal/audit"
+	"github.com/vkumov/go-pxgrider/server/internal/auth"
 	"github.com/vkumov/go-pxgrider/server/internal/connection"
+	"github.com/vkumov/go-pxgrider/server/internal/db"
 )
 
 type (
@@ -17,10 +21,12 @@ type (
 
 	DBer interface {
 		DB() *sql.DB
+		Dialect() db.Dialect
 	}
 
 	UserHandler interface {
 		LoadFromDB(ctx context.Context) error
+		Refresh(ctx context.Context) (connection.RefreshResultSlice, error)
 		AddConnection(ctx context.Context, req connection.ConnectionCreate) (*connection.Connection, error)
 		GetConnection(id string) (*connection.Connection, error)
 		FindConnection(name string) (*connection.Connection, error)
@@ -31,12 +37,17 @@ type (
 
 	UsersHandler interface {
 		GetUser(context.Context, string) UserHandler
+		LoadAll(context.Context) error
+		AllConnections() []*connection.Connection
 	}
 
 	App interface {
 		Start() error
+		Shutdown(ctx context.Context) error
 		Log() *zerolog.Logger
 		Users() UsersHandler
+		Tokens() *auth.Store
+		Audit() *audit.Recorder
 		Ready() <-chan struct{}
 	}
 )